@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/builtin/gen"
@@ -84,3 +85,89 @@ func TestABI(t *testing.T) {
 
 	}
 }
+
+func TestDecodeWithTopics(t *testing.T) {
+	abi, err := abi.New([]byte(`[
+		{"type":"event","name":"Log","inputs":[
+			{"name":"from","type":"address","indexed":true},
+			{"name":"tag","type":"string","indexed":true},
+			{"name":"","type":"uint256","indexed":false},
+			{"name":"flag","type":"bool","indexed":true},
+			{"name":"memo","type":"string","indexed":false}]},
+		{"type":"event","name":"Anon","anonymous":true,"inputs":[
+			{"name":"key","type":"bytes32","indexed":true}]}
+	]`))
+	assert.Nil(t, err)
+
+	event, _ := abi.EventByName("Log")
+	from := thor.BytesToAddress([]byte("from"))
+	tag := thor.Bytes32(crypto.Keccak256Hash([]byte("tag")))
+	data, err := event.Encode(big.NewInt(1), "memo")
+	assert.Nil(t, err)
+
+	topics := []thor.Bytes32{
+		event.ID(),
+		thor.BytesToBytes32(from.Bytes()),
+		tag,
+		thor.BytesToBytes32([]byte{1}),
+	}
+	decoded, err := event.DecodeWithTopics(topics, data)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"from": common.Address(from),
+		"tag":  tag,
+		"2":    big.NewInt(1),
+		"flag": true,
+		"memo": "memo",
+	}, decoded)
+
+	_, err = event.DecodeWithTopics(topics[:3], data)
+	assert.NotNil(t, err, "insufficient topics")
+
+	_, err = event.DecodeWithTopics(topics[1:], data)
+	assert.NotNil(t, err, "event id mismatch")
+
+	anon, _ := abi.EventByName("Anon")
+	assert.True(t, anon.Anonymous())
+	decoded, err = anon.DecodeWithTopics([]thor.Bytes32{tag}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"key": [32]byte(tag)}, decoded)
+}
+
+func TestUnpackRevert(t *testing.T) {
+	// Error("boom")
+	data := common.FromHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000")
+	reason, err := abi.UnpackRevert(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "boom", reason)
+
+	// Panic(0x11)
+	data = common.FromHex("0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	reason, err = abi.UnpackRevert(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+
+	// Panic(0x99)
+	data = common.FromHex("0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000099")
+	reason, err = abi.UnpackRevert(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "panic: unknown (0x99)", reason)
+
+	// custom error
+	_, err = abi.UnpackRevert(common.FromHex("0x12345678"))
+	assert.NotNil(t, err)
+	_, err = abi.UnpackRevert(nil)
+	assert.NotNil(t, err)
+
+	// truncated
+	_, err = abi.UnpackRevert(common.FromHex("0x08c379a0"))
+	assert.NotNil(t, err)
+
+	assert.Equal(t, common.FromHex("0x08c379a0"), crypto.Keccak256([]byte("Error(string)"))[:4])
+	assert.Equal(t, common.FromHex("0x4e487b71"), crypto.Keccak256([]byte("Panic(uint256)"))[:4])
+}
@@ -6,6 +6,9 @@
 package abi
 
 import (
+	"errors"
+	"strconv"
+
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/vechain/thor/thor"
 )
@@ -50,3 +53,55 @@ func (e *Event) Encode(args ...interface{}) ([]byte, error) {
 func (e *Event) Decode(data []byte, v interface{}) error {
 	return e.argsWithoutIndexed.Unpack(v, data)
 }
+
+// Anonymous returns whether the event is anonymous, which has no event id in topics.
+func (e *Event) Anonymous() bool {
+	return e.event.Anonymous
+}
+
+// DecodeWithTopics decodes all arguments of the event, indexed ones from topics and the others from data.
+// Values are keyed by argument name, or argument index if unnamed.
+// Indexed arguments of dynamic types are stored as hash, and returned as thor.Bytes32.
+func (e *Event) DecodeWithTopics(topics []thor.Bytes32, data []byte) (map[string]interface{}, error) {
+	if !e.event.Anonymous {
+		if len(topics) == 0 || topics[0] != e.id {
+			return nil, errors.New("event id mismatch")
+		}
+		topics = topics[1:]
+	}
+
+	values, err := e.argsWithoutIndexed.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make(map[string]interface{}, len(e.event.Inputs))
+	for i, arg := range e.event.Inputs {
+		key := arg.Name
+		if key == "" {
+			key = strconv.Itoa(i)
+		}
+		if !arg.Indexed {
+			decoded[key] = values[0]
+			values = values[1:]
+			continue
+		}
+		if len(topics) == 0 {
+			return nil, errors.New("insufficient topics")
+		}
+		topic := topics[0]
+		topics = topics[1:]
+
+		switch arg.Type.T {
+		case ethabi.IntTy, ethabi.UintTy, ethabi.BoolTy, ethabi.AddressTy, ethabi.FixedBytesTy:
+			v, err := ethabi.Arguments{{Type: arg.Type}}.UnpackValues(topic[:])
+			if err != nil {
+				return nil, err
+			}
+			decoded[key] = v[0]
+		default:
+			decoded[key] = topic
+		}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var (
+	// selector of Solidity's Error(string)
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// selector of Solidity's Panic(uint256)
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	stringArgs  = mustNewArguments("string")
+	uint256Args = mustNewArguments("uint256")
+
+	errNotStandardRevert = errors.New("not a standard revert")
+
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+func mustNewArguments(typ string) ethabi.Arguments {
+	t, err := ethabi.NewType(typ)
+	if err != nil {
+		panic(err)
+	}
+	return ethabi.Arguments{{Type: t}}
+}
+
+// UnpackRevert decodes the reason from data returned by a reverted execution,
+// which is encoded as Solidity's Error(string) or Panic(uint256).
+// An error returned if the data is in neither form, e.g. custom errors.
+func UnpackRevert(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", errNotStandardRevert
+	}
+	switch selector := data[:4]; {
+	case bytes.Equal(selector, errorSelector):
+		var reason string
+		if err := stringArgs.Unpack(&reason, data[4:]); err != nil {
+			return "", err
+		}
+		return reason, nil
+	case bytes.Equal(selector, panicSelector):
+		var code *big.Int
+		if err := uint256Args.Unpack(&code, data[4:]); err != nil {
+			return "", err
+		}
+		if code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				return fmt.Sprintf("panic: %s (0x%x)", reason, code), nil
+			}
+		}
+		return fmt.Sprintf("panic: unknown (0x%x)", code), nil
+	default:
+		return "", errNotStandardRevert
+	}
+}
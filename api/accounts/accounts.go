@@ -10,7 +10,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
-	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -26,6 +26,9 @@ import (
 	"github.com/vechain/thor/xenv"
 )
 
+// maxProofKeys limits the number of storage keys proved in one request.
+const maxProofKeys = 100
+
 type Accounts struct {
 	repo         *chain.Repository
 	stater       *state.Stater
@@ -63,7 +66,7 @@ func (a *Accounts) handleGetCode(w http.ResponseWriter, req *http.Request) error
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -112,7 +115,7 @@ func (a *Accounts) handleGetAccount(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -132,7 +135,7 @@ func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "key"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -143,12 +146,75 @@ func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) er
 	return utils.WriteJSON(w, map[string]string{"value": storage.String()})
 }
 
+func (a *Accounts) getProof(addr thor.Address, keys []thor.Bytes32, header *block.Header) (*AccountProof, error) {
+	state := a.stater.NewState(header.StateRoot())
+	accountProof, err := state.ProveAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	storageProofs := make([]*StorageProof, 0, len(keys))
+	for _, key := range keys {
+		value, err := state.GetStorage(addr, key)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := state.ProveStorage(addr, key)
+		if err != nil {
+			return nil, err
+		}
+		storageProofs = append(storageProofs, &StorageProof{
+			Key:   key,
+			Value: value,
+			Proof: encodeProof(proof),
+		})
+	}
+	return &AccountProof{
+		Address:      addr,
+		BlockID:      header.ID(),
+		StateRoot:    header.StateRoot(),
+		AccountProof: encodeProof(accountProof),
+		StorageProof: storageProofs,
+	}, nil
+}
+
+func (a *Accounts) handleGetProof(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	var keys []thor.Bytes32
+	for _, v := range req.URL.Query()["keys"] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			key, err := thor.ParseBytes32(s)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, "keys"))
+			}
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > maxProofKeys {
+		return utils.BadRequest(errors.Errorf("keys: exceeds limit %d", maxProofKeys))
+	}
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	proof, err := a.getProof(addr, keys, h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, proof)
+}
+
 func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request) error {
 	callData := &CallData{}
 	if err := utils.ParseJSON(req.Body, &callData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -184,7 +250,7 @@ func (a *Accounts) handleCallBatchCode(w http.ResponseWriter, req *http.Request)
 	if err := utils.ParseJSON(req.Body, &batchCallData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.GetHeaderByRevision(a.repo, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -196,11 +262,14 @@ func (a *Accounts) handleCallBatchCode(w http.ResponseWriter, req *http.Request)
 }
 
 func (a *Accounts) batchCall(ctx context.Context, batchCallData *BatchCallData, header *block.Header) (results BatchCallResults, err error) {
-	txCtx, gas, clauses, err := a.handleBatchCallData(batchCallData)
+	txCtx, gas, clauses, err := ResolveBatchCallData(batchCallData, a.callGasLimit)
 	if err != nil {
 		return nil, err
 	}
 	state := a.stater.NewState(header.StateRoot())
+	if err := batchCallData.StateOverrides.Apply(state, header.Timestamp()); err != nil {
+		return nil, err
+	}
 
 	signer, _ := header.Signer()
 	rt := runtime.New(a.repo.NewChain(header.ParentID()), state,
@@ -244,11 +313,13 @@ func (a *Accounts) batchCall(ctx context.Context, batchCallData *BatchCallData,
 	return results, nil
 }
 
-func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (txCtx *xenv.TransactionContext, gas uint64, clauses []*tx.Clause, err error) {
-	if batchCallData.Gas > a.callGasLimit {
+// ResolveBatchCallData resolves the tx context, gas and clauses to execute the batch call.
+// The gas defaults to the call gas limit, and is forbidden to exceed it.
+func ResolveBatchCallData(batchCallData *BatchCallData, callGasLimit uint64) (txCtx *xenv.TransactionContext, gas uint64, clauses []*tx.Clause, err error) {
+	if batchCallData.Gas > callGasLimit {
 		return nil, 0, nil, utils.Forbidden(errors.New("gas: exceeds limit"))
 	} else if batchCallData.Gas == 0 {
-		gas = a.callGasLimit
+		gas = callGasLimit
 	} else {
 		gas = batchCallData.Gas
 	}
@@ -280,10 +351,10 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (txCtx *xen
 	if len(batchCallData.BlockRef) > 0 {
 		blockRef, err := hexutil.Decode(batchCallData.BlockRef)
 		if err != nil {
-			return nil, 0, nil, errors.WithMessage(err, "blockRef")
+			return nil, 0, nil, utils.BadRequest(errors.WithMessage(err, "blockRef"))
 		}
 		if len(blockRef) != 8 {
-			return nil, 0, nil, errors.New("blockRef: invalid length")
+			return nil, 0, nil, utils.BadRequest(errors.New("blockRef: invalid length"))
 		}
 		var blkRef tx.BlockRef
 		copy(blkRef[:], blockRef[:])
@@ -311,41 +382,6 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (txCtx *xen
 	return
 }
 
-func (a *Accounts) handleRevision(revision string) (*block.Header, error) {
-	if revision == "" || revision == "best" {
-		return a.repo.BestBlock().Header(), nil
-	}
-	if len(revision) == 66 || len(revision) == 64 {
-		blockID, err := thor.ParseBytes32(revision)
-		if err != nil {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		summary, err := a.repo.GetBlockSummary(blockID)
-		if err != nil {
-			if a.repo.IsNotFound(err) {
-				return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-			}
-			return nil, err
-		}
-		return summary.Header, nil
-	}
-	n, err := strconv.ParseUint(revision, 0, 0)
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-	}
-	if n > math.MaxUint32 {
-		return nil, utils.BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
-	}
-	h, err := a.repo.NewBestChain().GetBlockHeader(uint32(n))
-	if err != nil {
-		if a.repo.IsNotFound(err) {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		return nil, err
-	}
-	return h, nil
-}
-
 func (a *Accounts) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
@@ -353,6 +389,7 @@ func (a *Accounts) Mount(root *mux.Router, pathPrefix string) {
 	sub.Path("/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetAccount))
 	sub.Path("/{address}/code").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetCode))
 	sub.Path("/{address}/storage/{key}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorage))
+	sub.Path("/{address}/proof").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetProof))
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
 	sub.Path("/{address}").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
 
@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	ABI "github.com/vechain/thor/abi"
@@ -29,6 +30,7 @@ import (
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/tx"
 )
 
@@ -105,6 +107,7 @@ func TestAccount(t *testing.T) {
 	getAccount(t)
 	getCode(t)
 	getStorage(t)
+	getProof(t)
 	deployContractWithCall(t)
 	callContract(t)
 	batchCall(t)
@@ -245,6 +248,66 @@ func packTx(repo *chain.Repository, stater *state.Stater, transaction *tx.Transa
 	}
 }
 
+type proofReader map[thor.Bytes32][]byte
+
+func (r proofReader) Get(key []byte) ([]byte, error) { return r[thor.BytesToBytes32(key)], nil }
+func (r proofReader) Has(key []byte) (bool, error) {
+	_, ok := r[thor.BytesToBytes32(key)]
+	return ok, nil
+}
+
+func newProofReader(t *testing.T, proof []string) proofReader {
+	r := make(proofReader)
+	for _, s := range proof {
+		node, err := hexutil.Decode(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r[thor.Blake2b(node)] = node
+	}
+	return r
+}
+
+func getProof(t *testing.T) {
+	_, statusCode := httpGet(t, ts.URL+"/accounts/"+invalidAddr+"/proof")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad address")
+
+	_, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?keys="+invalidBytes32)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad key")
+
+	_, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?revision="+invalidNumberRevision)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad revision")
+
+	otherKey := thor.BytesToBytes32([]byte("other"))
+	res, statusCode := httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?keys="+storageKey.String()+","+otherKey.String())
+	assert.Equal(t, http.StatusOK, statusCode, "OK")
+	var proof accounts.AccountProof
+	if err := json.Unmarshal(res, &proof); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, contractAddr, proof.Address)
+
+	enc, err, _ := trie.VerifyProof(proof.StateRoot, thor.Blake2b(contractAddr[:]).Bytes(), newProofReader(t, proof.AccountProof))
+	assert.Nil(t, err)
+	var acc state.Account
+	if err := rlp.DecodeBytes(enc, &acc); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, crypto.Keccak256(runtimeBytecode), acc.CodeHash)
+
+	assert.Equal(t, 2, len(proof.StorageProof))
+	assert.Equal(t, thor.BytesToBytes32([]byte{storageValue}), proof.StorageProof[0].Value)
+	enc, err, _ = trie.VerifyProof(thor.BytesToBytes32(acc.StorageRoot), thor.Blake2b(storageKey[:]).Bytes(), newProofReader(t, proof.StorageProof[0].Proof))
+	assert.Nil(t, err)
+	value, _ := rlp.EncodeToBytes([]byte{storageValue})
+	assert.Equal(t, value, enc)
+
+	assert.Equal(t, thor.Bytes32{}, proof.StorageProof[1].Value)
+	enc, err, _ = trie.VerifyProof(thor.BytesToBytes32(acc.StorageRoot), thor.Blake2b(otherKey[:]).Bytes(), newProofReader(t, proof.StorageProof[1].Proof))
+	assert.Nil(t, err)
+	assert.Nil(t, enc, "proof of absence")
+}
+
 func deployContractWithCall(t *testing.T) {
 	badBody := &accounts.CallData{
 		Gas:  10000000,
@@ -386,6 +449,97 @@ func batchCall(t *testing.T) {
 	}
 	_, statusCode = httpPost(t, ts.URL+"/accounts/*", fullBody)
 	assert.Equal(t, http.StatusOK, statusCode)
+
+	batchCallWithStateOverrides(t)
+	batchCallReverted(t)
+}
+
+func batchCallReverted(t *testing.T) {
+	// init code reverts with Error("boom")
+	revertCode := "0x6064600c60003960646000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000"
+	reqBody := &accounts.BatchCallData{
+		Clauses: accounts.Clauses{
+			accounts.Clause{Data: revertCode},
+		},
+	}
+	res, statusCode := httpPost(t, ts.URL+"/accounts/*", reqBody)
+	assert.Equal(t, http.StatusOK, statusCode)
+	var results accounts.BatchCallResults
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, results[0].Reverted)
+	assert.Equal(t, "boom", results[0].RevertReason)
+
+	// not decodable
+	reqBody.Clauses[0].Data = "0x600080fd"
+	res, _ = httpPost(t, ts.URL+"/accounts/*", reqBody)
+	results = nil
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, results[0].Reverted)
+	assert.Equal(t, "", results[0].RevertReason)
+}
+
+func batchCallWithStateOverrides(t *testing.T) {
+	target := thor.BytesToAddress([]byte("override"))
+	caller := thor.BytesToAddress([]byte("poor"))
+	// returns the value of storage slot 0
+	code := "0x60005460005260206000f3"
+	slotValue := thor.BytesToBytes32([]byte{0xab})
+	amount := math.HexOrDecimal256(*new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e9)))
+
+	reqBody := &accounts.BatchCallData{
+		Clauses: accounts.Clauses{
+			accounts.Clause{To: &target},
+			accounts.Clause{To: &target, Value: &amount},
+		},
+		Caller: &caller,
+		StateOverrides: accounts.StateOverrides{
+			target.String(): {
+				Code:    &code,
+				Storage: map[string]string{thor.Bytes32{}.String(): slotValue.String()},
+			},
+			caller.String(): {
+				Balance: &amount,
+			},
+		},
+	}
+	res, statusCode := httpPost(t, ts.URL+"/accounts/*", reqBody)
+	assert.Equal(t, http.StatusOK, statusCode)
+	var results accounts.BatchCallResults
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, slotValue.String(), results[0].Data)
+	assert.False(t, results[1].Reverted)
+	assert.Equal(t, 1, len(results[1].Transfers))
+
+	// overrides never committed
+	reqBody.StateOverrides = nil
+	res, statusCode = httpPost(t, ts.URL+"/accounts/*", reqBody)
+	assert.Equal(t, http.StatusOK, statusCode)
+	results = nil
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0x", results[0].Data)
+	assert.True(t, results[1].Reverted)
+
+	badCode := "0xzz"
+	reqBody.StateOverrides = accounts.StateOverrides{target.String(): {Code: &badCode}}
+	_, statusCode = httpPost(t, ts.URL+"/accounts/*", reqBody)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	reqBody.StateOverrides = accounts.StateOverrides{"abc": {}}
+	_, statusCode = httpPost(t, ts.URL+"/accounts/*", reqBody)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
 }
 
 func httpPost(t *testing.T, url string, body interface{}) ([]byte, int) {
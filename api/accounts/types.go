@@ -6,10 +6,17 @@
 package accounts
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 )
 
@@ -20,6 +27,30 @@ type Account struct {
 	HasCode bool                 `json:"hasCode"`
 }
 
+//AccountProof for marshal merkle proofs of account and storage slots
+type AccountProof struct {
+	Address      thor.Address    `json:"address"`
+	BlockID      thor.Bytes32    `json:"blockID"`
+	StateRoot    thor.Bytes32    `json:"stateRoot"`
+	AccountProof []string        `json:"accountProof"`
+	StorageProof []*StorageProof `json:"storageProof"`
+}
+
+//StorageProof for marshal merkle proof of a storage slot
+type StorageProof struct {
+	Key   thor.Bytes32 `json:"key"`
+	Value thor.Bytes32 `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+func encodeProof(proof [][]byte) []string {
+	encoded := make([]string, 0, len(proof))
+	for _, node := range proof {
+		encoded = append(encoded, hexutil.Encode(node))
+	}
+	return encoded
+}
+
 //CallData represents contract-call body
 type CallData struct {
 	Value    *math.HexOrDecimal256 `json:"value"`
@@ -30,24 +61,28 @@ type CallData struct {
 }
 
 type CallResult struct {
-	Data      string                   `json:"data"`
-	Events    []*transactions.Event    `json:"events"`
-	Transfers []*transactions.Transfer `json:"transfers"`
-	GasUsed   uint64                   `json:"gasUsed"`
-	Reverted  bool                     `json:"reverted"`
-	VMError   string                   `json:"vmError"`
+	Data         string                   `json:"data"`
+	Events       []*transactions.Event    `json:"events"`
+	Transfers    []*transactions.Transfer `json:"transfers"`
+	GasUsed      uint64                   `json:"gasUsed"`
+	Reverted     bool                     `json:"reverted"`
+	VMError      string                   `json:"vmError"`
+	RevertReason string                   `json:"revertReason"`
 }
 
 func convertCallResultWithInputGas(vo *runtime.Output, inputGas uint64) *CallResult {
 	gasUsed := inputGas - vo.LeftOverGas
 	var (
-		vmError  string
-		reverted bool
+		vmError      string
+		reverted     bool
+		revertReason string
 	)
 
 	if vo.VMErr != nil {
 		reverted = true
 		vmError = vo.VMErr.Error()
+		// leave it empty if not decodable, e.g. custom errors
+		revertReason, _ = abi.UnpackRevert(vo.Data)
 	}
 
 	events := make([]*transactions.Event, len(vo.Events))
@@ -74,12 +109,13 @@ func convertCallResultWithInputGas(vo *runtime.Output, inputGas uint64) *CallRes
 	}
 
 	return &CallResult{
-		Data:      hexutil.Encode(vo.Data),
-		Events:    events,
-		Transfers: transfers,
-		GasUsed:   gasUsed,
-		Reverted:  reverted,
-		VMError:   vmError,
+		Data:         hexutil.Encode(vo.Data),
+		Events:       events,
+		Transfers:    transfers,
+		GasUsed:      gasUsed,
+		Reverted:     reverted,
+		VMError:      vmError,
+		RevertReason: revertReason,
 	}
 }
 
@@ -94,14 +130,75 @@ type Clauses []Clause
 
 //BatchCallData executes a batch of codes
 type BatchCallData struct {
-	Clauses    Clauses               `json:"clauses"`
-	Gas        uint64                `json:"gas"`
-	GasPrice   *math.HexOrDecimal256 `json:"gasPrice"`
-	ProvedWork *math.HexOrDecimal256 `json:"provedWork"`
-	Caller     *thor.Address         `json:"caller"`
-	GasPayer   *thor.Address         `json:"gasPayer"`
-	Expiration uint32                `json:"expiration"`
-	BlockRef   string                `json:"blockRef"`
+	Clauses        Clauses               `json:"clauses"`
+	Gas            uint64                `json:"gas"`
+	GasPrice       *math.HexOrDecimal256 `json:"gasPrice"`
+	ProvedWork     *math.HexOrDecimal256 `json:"provedWork"`
+	Caller         *thor.Address         `json:"caller"`
+	GasPayer       *thor.Address         `json:"gasPayer"`
+	Expiration     uint32                `json:"expiration"`
+	BlockRef       string                `json:"blockRef"`
+	StateOverrides StateOverrides        `json:"stateOverrides"`
 }
 
 type BatchCallResults []*CallResult
+
+//AccountOverride replaces parts of an account's state, absent fields are kept.
+type AccountOverride struct {
+	Code    *string               `json:"code"`
+	Balance *math.HexOrDecimal256 `json:"balance"`
+	Energy  *math.HexOrDecimal256 `json:"energy"`
+	Storage map[string]string     `json:"storage"`
+}
+
+//StateOverrides maps address to its account override.
+type StateOverrides map[string]*AccountOverride
+
+// Apply applies overrides to the state. Energy is set as of the block time.
+// The state is expected to be discarded after use.
+func (so StateOverrides) Apply(st *state.State, blockTime uint64) error {
+	for key, override := range so {
+		if override == nil {
+			continue
+		}
+		field := func(name string) string {
+			return fmt.Sprintf("stateOverrides[%s].%s", key, name)
+		}
+		addr, err := thor.ParseAddress(key)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, fmt.Sprintf("stateOverrides[%s]", key)))
+		}
+
+		if override.Code != nil {
+			code, err := hexutil.Decode(*override.Code)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, field("code")))
+			}
+			if err := st.SetCode(addr, code); err != nil {
+				return err
+			}
+		}
+		if override.Balance != nil {
+			if err := st.SetBalance(addr, (*big.Int)(override.Balance)); err != nil {
+				return err
+			}
+		}
+		if override.Energy != nil {
+			if err := st.SetEnergy(addr, (*big.Int)(override.Energy), blockTime); err != nil {
+				return err
+			}
+		}
+		for k, v := range override.Storage {
+			storageKey, err := thor.ParseBytes32(k)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, field("storage")))
+			}
+			storageValue, err := thor.ParseBytes32(v)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, field("storage")))
+			}
+			st.SetStorage(addr, storageKey, storageValue)
+		}
+	}
+	return nil
+}
@@ -17,8 +17,11 @@ import (
 	"github.com/vechain/thor/api/blocks"
 	"github.com/vechain/thor/api/debug"
 	"github.com/vechain/thor/api/doc"
+	"github.com/vechain/thor/api/eth"
 	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/api/node"
+	"github.com/vechain/thor/api/pool"
+	"github.com/vechain/thor/api/reverted"
 	"github.com/vechain/thor/api/subscriptions"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/api/transfers"
@@ -34,7 +37,7 @@ func New(
 	repo *chain.Repository,
 	stater *state.Stater,
 	txPool *txpool.TxPool,
-	logDB *logdb.LogDB,
+	logDB logdb.LogDB,
 	nw node.Network,
 	allowedOrigins string,
 	backtraceLimit uint32,
@@ -73,16 +76,26 @@ func New(
 			Mount(router, "/logs/event")
 		transfers.New(repo, logDB).
 			Mount(router, "/logs/transfer")
+		reverted.New(repo, logDB).
+			Mount(router, "/logs/reverted")
 	}
 	blocks.New(repo).
 		Mount(router, "/blocks")
-	transactions.New(repo, txPool).
+	transactions.New(repo, stater, txPool, callGasLimit, forkConfig).
 		Mount(router, "/transactions")
-	debug.New(repo, stater, forkConfig).
+	debug.New(repo, stater, callGasLimit, forkConfig).
 		Mount(router, "/debug")
 	node.New(nw).
 		Mount(router, "/node")
-	subs := subscriptions.New(repo, origins, backtraceLimit)
+	pool.New(repo, stater, txPool).
+		Mount(router, "/txpool")
+	ethLogDB := logDB
+	if skipLogs {
+		ethLogDB = nil
+	}
+	eth.New(repo, stater, ethLogDB, callGasLimit, forkConfig).
+		Mount(router, "/rpc")
+	subs := subscriptions.New(repo, origins, backtraceLimit, txPool)
 	subs.Mount(router, "/subscriptions")
 
 	if pprofOn {
@@ -97,7 +110,7 @@ func New(
 	handler = handlers.CORS(
 		handlers.AllowedOrigins(origins),
 		handlers.AllowedHeaders([]string{"content-type", "x-genesis-id"}),
-		handlers.ExposedHeaders([]string{"x-genesis-id", "x-thorest-ver"}),
+		handlers.ExposedHeaders([]string{"x-genesis-id", "x-thorest-ver", "x-thorest-next"}),
 	)(handler)
 	return handler.ServeHTTP,
 		subs.Close // subscriptions handles hijacked conns, which need to be closed
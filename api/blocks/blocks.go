@@ -6,17 +6,30 @@
 package blocks
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
 )
 
+const (
+	// max number of blocks in one page of block range
+	maxPageSize = 100
+
+	// response header carries the cursor of the next page
+	nextCursorHeader = "x-thorest-next"
+
+	ndjsonContentType = "application/x-ndjson"
+)
+
 type Blocks struct {
 	repo *chain.Repository
 }
@@ -72,6 +85,153 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 	})
 }
 
+func (b *Blocks) handleGetBlocks(w http.ResponseWriter, req *http.Request) error {
+	query := req.URL.Query()
+	expanded := query.Get("expanded")
+	if expanded != "" && expanded != "false" && expanded != "true" {
+		return utils.BadRequest(errors.WithMessage(errors.New("should be boolean"), "expanded"))
+	}
+
+	// the page is read from a snapshot of trunk
+	trunk := b.repo.NewBestChain()
+
+	to := block.Number(trunk.HeadID())
+	if s := query.Get("to"); s != "" {
+		n, err := parseBlockNumber(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "to"))
+		}
+		if n < to {
+			to = n
+		}
+	}
+
+	var from uint32
+	if s := query.Get("cursor"); s != "" {
+		cursor, err := thor.ParseBytes32(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "cursor"))
+		}
+		// the cursor block might be reorganized out of trunk
+		id, err := trunk.GetBlockID(block.Number(cursor))
+		if err != nil && !trunk.IsNotFound(err) {
+			return err
+		}
+		if err != nil || id != cursor {
+			return utils.HTTPError(errors.New("cursor: block not in trunk"), http.StatusConflict)
+		}
+		from = block.Number(cursor) + 1
+	} else if s := query.Get("from"); s != "" {
+		n, err := parseBlockNumber(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "from"))
+		}
+		from = n
+	} else {
+		return utils.BadRequest(errors.New("from: required if no cursor"))
+	}
+
+	limit := uint64(maxPageSize)
+	if s := query.Get("limit"); s != "" {
+		n, err := strconv.ParseUint(s, 0, 0)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "limit"))
+		}
+		if n == 0 || n > maxPageSize {
+			return utils.BadRequest(errors.Errorf("limit: should be in range [1, %d]", maxPageSize))
+		}
+		limit = n
+	}
+
+	// [from, end] is the page to be returned, which is empty if from > end
+	end := uint64(to)
+	if uint64(from)+limit <= end {
+		end = uint64(from) + limit - 1
+	}
+
+	var next *thor.Bytes32
+	if end < uint64(to) {
+		id, err := trunk.GetBlockID(uint32(end))
+		if err != nil {
+			return err
+		}
+		next = &id
+		w.Header().Set(nextCursorHeader, id.String())
+	}
+
+	ndjson := strings.Contains(req.Header.Get("Accept"), ndjsonContentType)
+	if ndjson {
+		w.Header().Set("Content-Type", ndjsonContentType)
+	} else {
+		w.Header().Set("Content-Type", utils.JSONContentType)
+		if _, err := w.Write([]byte(`{"blocks":[`)); err != nil {
+			return err
+		}
+	}
+
+	// blocks are streamed one by one, to not hold a whole page in memory
+	enc := json.NewEncoder(w)
+	for num := uint64(from); num <= end; num++ {
+		jBlock, err := b.getTrunkBlock(trunk, uint32(num), expanded == "true")
+		if err != nil {
+			return err
+		}
+		if !ndjson && num > uint64(from) {
+			if _, err := w.Write([]byte(",")); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(jBlock); err != nil {
+			return err
+		}
+	}
+
+	if !ndjson {
+		if _, err := w.Write([]byte(`],"next":`)); err != nil {
+			return err
+		}
+		if err := enc.Encode(next); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte("}\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Blocks) getTrunkBlock(trunk *chain.Chain, num uint32, expanded bool) (interface{}, error) {
+	blk, err := trunk.GetBlock(num)
+	if err != nil {
+		return nil, err
+	}
+	txs := blk.Transactions()
+	summary := &chain.BlockSummary{
+		Header: blk.Header(),
+		Txs:    make([]thor.Bytes32, 0, len(txs)),
+		Size:   uint64(blk.Size()),
+	}
+	for _, tx := range txs {
+		summary.Txs = append(summary.Txs, tx.ID())
+	}
+
+	jSummary := buildJSONBlockSummary(summary, true)
+	if expanded {
+		receipts, err := b.repo.GetBlockReceipts(summary.Header.ID())
+		if err != nil {
+			return nil, err
+		}
+		return &JSONExpandedBlock{
+			jSummary,
+			buildJSONEmbeddedTxs(txs, receipts),
+		}, nil
+	}
+	return &JSONCollapsedBlock{
+		jSummary,
+		summary.Txs,
+	}, nil
+}
+
 func (b *Blocks) parseRevision(revision string) (interface{}, error) {
 	if revision == "" || revision == "best" {
 		return nil, nil
@@ -83,14 +243,18 @@ func (b *Blocks) parseRevision(revision string) (interface{}, error) {
 		}
 		return blockID, nil
 	}
-	n, err := strconv.ParseUint(revision, 0, 0)
+	return parseBlockNumber(revision)
+}
+
+func parseBlockNumber(s string) (uint32, error) {
+	n, err := strconv.ParseUint(s, 0, 0)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 	if n > math.MaxUint32 {
-		return nil, errors.New("block number out of max uint32")
+		return 0, errors.New("block number out of max uint32")
 	}
-	return uint32(n), err
+	return uint32(n), nil
 }
 
 func (b *Blocks) getBlockSummary(revision interface{}) (s *chain.BlockSummary, err error) {
@@ -119,6 +283,7 @@ func (b *Blocks) isTrunk(blkID thor.Bytes32, blkNum uint32) (bool, error) {
 
 func (b *Blocks) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
+	sub.Path("").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlocks))
 	sub.Path("/{revision}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlock))
 
 }
@@ -70,6 +70,77 @@ func TestBlock(t *testing.T) {
 	checkBlock(t, blk, rb)
 	assert.Equal(t, http.StatusOK, statusCode)
 
+	testBlockRange(t)
+}
+
+func testBlockRange(t *testing.T) {
+	type page struct {
+		Blocks []*JSONCollapsedBlock `json:"blocks"`
+		Next   *thor.Bytes32         `json:"next"`
+	}
+
+	_, statusCode := httpGet(t, ts.URL+"/blocks")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	_, statusCode = httpGet(t, ts.URL+"/blocks?from=0&limit=101")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	res, statusCode := httpGet(t, ts.URL+"/blocks?from=0")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var p page
+	if err := json.Unmarshal(res, &p); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(p.Blocks))
+	assert.Nil(t, p.Next)
+	assert.True(t, p.Blocks[0].IsTrunk)
+	checkBlock(t, blk, p.Blocks[1])
+
+	res, _ = httpGet(t, ts.URL+"/blocks?from=0&limit=1")
+	p = page{}
+	if err := json.Unmarshal(res, &p); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(p.Blocks))
+	assert.Equal(t, blk.Header().ParentID(), *p.Next)
+
+	// continue from cursor
+	res, _ = httpGet(t, ts.URL+"/blocks?expanded=true&cursor="+p.Next.String())
+	var expanded struct {
+		Blocks []*JSONExpandedBlock `json:"blocks"`
+		Next   *thor.Bytes32        `json:"next"`
+	}
+	if err := json.Unmarshal(res, &expanded); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(expanded.Blocks))
+	assert.Equal(t, blk.Header().ID(), expanded.Blocks[0].ID)
+	assert.Equal(t, blk.Transactions()[0].ID(), expanded.Blocks[0].Transactions[0].ID)
+	assert.Nil(t, expanded.Next)
+
+	// cursor not in trunk
+	_, statusCode = httpGet(t, ts.URL+"/blocks?cursor="+thor.Bytes32{}.String())
+	assert.Equal(t, http.StatusConflict, statusCode)
+
+	// ndjson
+	req, _ := http.NewRequest("GET", ts.URL+"/blocks?from=1", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	dec := json.NewDecoder(resp.Body)
+	var n int
+	for dec.More() {
+		var b JSONCollapsedBlock
+		if err := dec.Decode(&b); err != nil {
+			t.Fatal(err)
+		}
+		checkBlock(t, blk, &b)
+		n++
+	}
+	assert.Equal(t, 1, n)
 }
 
 func initBlockServer(t *testing.T) {
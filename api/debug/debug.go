@@ -16,9 +16,10 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/accounts"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
-	"github.com/vechain/thor/consensus"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
@@ -26,20 +27,21 @@ import (
 	"github.com/vechain/thor/tracers"
 	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/vm"
+	"github.com/vechain/thor/xenv"
 )
 
-var devNetGenesisID = thor.MustParseBytes32("0x00000000973ceb7f343a58b08f0693d6701a5fd354ff73d7058af3fba222aea4")
-
 type Debug struct {
-	repo       *chain.Repository
-	stater     *state.Stater
-	forkConfig thor.ForkConfig
+	repo         *chain.Repository
+	stater       *state.Stater
+	callGasLimit uint64
+	forkConfig   thor.ForkConfig
 }
 
-func New(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig) *Debug {
+func New(repo *chain.Repository, stater *state.Stater, callGasLimit uint64, forkConfig thor.ForkConfig) *Debug {
 	return &Debug{
 		repo,
 		stater,
+		callGasLimit,
 		forkConfig,
 	}
 }
@@ -59,12 +61,7 @@ func (d *Debug) handleTxEnv(ctx context.Context, blockID thor.Bytes32, txIndex u
 	if clauseIndex >= uint64(len(txs[txIndex].Clauses())) {
 		return nil, nil, utils.Forbidden(errors.New("clause index out of range"))
 	}
-	skipPoA := d.repo.GenesisBlock().Header().ID() == devNetGenesisID
-	rt, err := consensus.New(
-		d.repo,
-		d.stater,
-		d.forkConfig,
-	).NewRuntimeForReplay(block.Header(), skipPoA)
+	rt, err := utils.NewReplayRuntime(d.repo, d.stater, d.forkConfig, block.Header())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,6 +106,133 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 	if err != nil {
 		return nil, err
 	}
+	return traceResult(tracer, gasUsed, output)
+}
+
+// trace all clauses of txs in a block, in one pass of execution
+func (d *Debug) traceBlock(ctx context.Context, newTracer func() (vm.Tracer, error), blockID thor.Bytes32) ([]*TxTraceResult, error) {
+	block, err := d.repo.GetBlock(blockID)
+	if err != nil {
+		if d.repo.IsNotFound(err) {
+			return nil, utils.Forbidden(errors.New("block not found"))
+		}
+		return nil, err
+	}
+	rt, err := utils.NewReplayRuntime(d.repo, d.stater, d.forkConfig, block.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+	results := make([]*TxTraceResult, 0, len(txs))
+	for _, tx := range txs {
+		txExec, err := rt.PrepareTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+		result := &TxTraceResult{
+			TxID:    tx.ID(),
+			Clauses: make([]interface{}, 0, len(tx.Clauses())),
+		}
+		// clauses after the reverted one are not executed
+		for txExec.HasNextClause() {
+			tracer, err := newTracer()
+			if err != nil {
+				return nil, err
+			}
+			rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+			gasUsed, output, err := txExec.NextClause()
+			if err != nil {
+				return nil, err
+			}
+			clauseResult, err := traceResult(tracer, gasUsed, output)
+			if err != nil {
+				return nil, err
+			}
+			result.Clauses = append(result.Clauses, clauseResult)
+		}
+		rt.SetVMConfig(vm.Config{})
+		if _, err := txExec.Finalize(); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+	}
+	return results, nil
+}
+
+// trace clauses as a call on the state of the given block
+func (d *Debug) traceCall(ctx context.Context, newTracer func() (vm.Tracer, error), opt *TraceCallOption, header *block.Header) ([]interface{}, error) {
+	txCtx, gas, clauses, err := accounts.ResolveBatchCallData(&opt.BatchCallData, d.callGasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	state := d.stater.NewState(header.StateRoot())
+	if err := opt.StateOverrides.Apply(state, header.Timestamp()); err != nil {
+		return nil, err
+	}
+
+	signer, _ := header.Signer()
+	rt := runtime.New(d.repo.NewChain(header.ParentID()), state,
+		&xenv.BlockContext{
+			Beneficiary: header.Beneficiary(),
+			Signer:      signer,
+			Number:      header.Number(),
+			Time:        header.Timestamp(),
+			GasLimit:    header.GasLimit(),
+			TotalScore:  header.TotalScore(),
+		},
+		d.forkConfig)
+
+	results := make([]interface{}, 0, len(clauses))
+	for i, clause := range clauses {
+		tracer, err := newTracer()
+		if err != nil {
+			return nil, err
+		}
+		rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+
+		exec, interrupt := rt.PrepareClause(clause, uint32(i), gas, txCtx)
+		type execResult struct {
+			output *runtime.Output
+			err    error
+		}
+		resultCh := make(chan execResult, 1)
+		go func() {
+			out, _, err := exec()
+			resultCh <- execResult{out, err}
+		}()
+
+		var result execResult
+		select {
+		case <-ctx.Done():
+			interrupt()
+			return nil, ctx.Err()
+		case result = <-resultCh:
+		}
+		if result.err != nil {
+			return nil, result.err
+		}
+		clauseResult, err := traceResult(tracer, gas-result.output.LeftOverGas, result.output)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, clauseResult)
+		if result.output.VMErr != nil {
+			break
+		}
+		gas = result.output.LeftOverGas
+	}
+	return results, nil
+}
+
+func traceResult(tracer vm.Tracer, gasUsed uint64, output *runtime.Output) (interface{}, error) {
 	switch tr := tracer.(type) {
 	case *vm.StructLogger:
 		return &ExecutionResult{
@@ -124,6 +248,26 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 	}
 }
 
+// tracerFactory returns a function to create tracer of the given name.
+// The default struct logger is used if name is empty.
+func tracerFactory(name string) (func() (vm.Tracer, error), error) {
+	if name == "" {
+		return func() (vm.Tracer, error) {
+			return vm.NewStructLogger(nil), nil
+		}, nil
+	}
+	if !strings.HasSuffix(name, "Tracer") {
+		name += "Tracer"
+	}
+	code, ok := tracers.CodeByName(name)
+	if !ok {
+		return nil, utils.BadRequest(errors.New("name: unsupported tracer"))
+	}
+	return func() (vm.Tracer, error) {
+		return tracers.New(code)
+	}, nil
+}
+
 func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request) error {
 	var opt *TracerOption
 	if err := utils.ParseJSON(req.Body, &opt); err != nil {
@@ -132,23 +276,13 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	if opt == nil {
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
-	var tracer vm.Tracer
-	if opt.Name == "" {
-		tracer = vm.NewStructLogger(nil)
-	} else {
-		name := opt.Name
-		if !strings.HasSuffix(name, "Tracer") {
-			name += "Tracer"
-		}
-		code, ok := tracers.CodeByName(name)
-		if !ok {
-			return utils.BadRequest(errors.New("name: unsupported tracer"))
-		}
-		tr, err := tracers.New(code)
-		if err != nil {
-			return err
-		}
-		tracer = tr
+	newTracer, err := tracerFactory(opt.Name)
+	if err != nil {
+		return err
+	}
+	tracer, err := newTracer()
+	if err != nil {
+		return err
 	}
 	blockID, txIndex, clauseIndex, err := d.parseTarget(opt.Target)
 	if err != nil {
@@ -161,6 +295,52 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	return utils.WriteJSON(w, res)
 }
 
+func (d *Debug) handleTraceBlock(w http.ResponseWriter, req *http.Request) error {
+	var opt *TracerOption
+	if err := utils.ParseJSON(req.Body, &opt); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if opt == nil {
+		return utils.BadRequest(errors.New("body: empty body"))
+	}
+	newTracer, err := tracerFactory(opt.Name)
+	if err != nil {
+		return err
+	}
+	blockID, err := thor.ParseBytes32(opt.Target)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "target"))
+	}
+	res, err := d.traceBlock(req.Context(), newTracer, blockID)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, res)
+}
+
+func (d *Debug) handleTraceCall(w http.ResponseWriter, req *http.Request) error {
+	var opt *TraceCallOption
+	if err := utils.ParseJSON(req.Body, &opt); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if opt == nil {
+		return utils.BadRequest(errors.New("body: empty body"))
+	}
+	newTracer, err := tracerFactory(opt.Name)
+	if err != nil {
+		return err
+	}
+	header, err := utils.GetHeaderByRevision(d.repo, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	res, err := d.traceCall(req.Context(), newTracer, opt, header)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, res)
+}
+
 func (d *Debug) debugStorage(ctx context.Context, contractAddress thor.Address, blockID thor.Bytes32, txIndex uint64, clauseIndex uint64, keyStart []byte, maxResult int) (*StorageRangeResult, error) {
 	rt, _, err := d.handleTxEnv(ctx, blockID, txIndex, clauseIndex)
 	if err != nil {
@@ -264,6 +444,8 @@ func (d *Debug) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/tracers").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransaction))
+	sub.Path("/tracers/block").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceBlock))
+	sub.Path("/tracers/call").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceCall))
 	sub.Path("/storage-range").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleDebugStorage))
 
 }
@@ -0,0 +1,224 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/debug"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var (
+	ts       *httptest.Server
+	blockID  thor.Bytes32
+	txs      tx.Transactions
+	to       = thor.BytesToAddress([]byte("to"))
+	reverter = thor.BytesToAddress([]byte("reverter"))
+)
+
+func TestDebug(t *testing.T) {
+	initDebugServer(t)
+	defer ts.Close()
+
+	traceBlock(t)
+	traceCall(t)
+}
+
+func traceBlock(t *testing.T) {
+	// default struct logger
+	var results []*debug.TxTraceResult
+	res, statusCode := httpPost(t, ts.URL+"/debug/tracers/block", &debug.TracerOption{Target: blockID.String()})
+	assert.Equal(t, http.StatusOK, statusCode, string(res))
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(txs), len(results))
+	for i, result := range results {
+		assert.Equal(t, txs[i].ID(), result.TxID)
+		assert.Equal(t, len(txs[i].Clauses()), len(result.Clauses))
+	}
+	var execResult debug.ExecutionResult
+	if err := json.Unmarshal(mustMarshal(t, results[0].Clauses[0]), &execResult); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, execResult.Failed)
+	assert.Equal(t, "0x", execResult.ReturnValue)
+
+	// named tracer
+	results = nil
+	res, statusCode = httpPost(t, ts.URL+"/debug/tracers/block", &debug.TracerOption{Name: "call", Target: blockID.String()})
+	assert.Equal(t, http.StatusOK, statusCode, string(res))
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(txs), len(results))
+	call := results[1].Clauses[1].(map[string]interface{})
+	assert.Equal(t, "CALL", call["type"])
+	assert.Equal(t, to.String(), call["to"])
+
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/block", &debug.TracerOption{Target: thor.Bytes32{}.String()})
+	assert.Equal(t, http.StatusForbidden, statusCode, "block not found")
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/block", &debug.TracerOption{Target: "0x01"})
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad target")
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/block", &debug.TracerOption{Name: "nonexistent", Target: blockID.String()})
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad tracer")
+}
+
+func traceCall(t *testing.T) {
+	code := "0x600080fd" // revert(0, 0)
+	caller := genesis.DevAccounts()[0].Address
+	opt := &debug.TraceCallOption{
+		Name: "call",
+		BatchCallData: accounts.BatchCallData{
+			Clauses: accounts.Clauses{
+				{To: &to, Value: (*math.HexOrDecimal256)(big.NewInt(1))},
+				{To: &reverter},
+				{To: &to},
+			},
+			Caller: &caller,
+			StateOverrides: accounts.StateOverrides{
+				reverter.String(): {Code: &code},
+			},
+		},
+	}
+
+	var results []map[string]interface{}
+	res, statusCode := httpPost(t, ts.URL+"/debug/tracers/call", opt)
+	assert.Equal(t, http.StatusOK, statusCode, string(res))
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	// clauses after the reverted one are not executed
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, to.String(), results[0]["to"])
+	assert.Equal(t, "0x1", results[0]["value"])
+	assert.Equal(t, reverter.String(), results[1]["to"])
+	assert.NotNil(t, results[1]["error"])
+
+	// on the state of the genesis block, without the overridden code
+	results = nil
+	res, statusCode = httpPost(t, ts.URL+"/debug/tracers/call?revision=0", opt)
+	assert.Equal(t, http.StatusOK, statusCode, string(res))
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(results))
+
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call?revision=abc", opt)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad revision")
+
+	opt.Gas = 100000000
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call", opt)
+	assert.Equal(t, http.StatusForbidden, statusCode, "gas exceeds limit")
+
+	opt.Gas = 0
+	opt.BlockRef = "0x01"
+	_, statusCode = httpPost(t, ts.URL+"/debug/tracers/call", opt)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad blockRef")
+}
+
+func initDebugServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	gene := genesis.NewDevnet()
+
+	b, _, _, err := gene.Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+
+	txs = tx.Transactions{
+		newTx(t, repo.ChainTag(), 0, genesis.DevAccounts()[0], tx.NewClause(&to).WithValue(big.NewInt(1))),
+		newTx(t, repo.ChainTag(), 1, genesis.DevAccounts()[1], tx.NewClause(&to), tx.NewClause(&to).WithValue(big.NewInt(2))),
+	}
+
+	packer := packer.New(repo, stater, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address, thor.NoFork)
+	flow, err := packer.Schedule(b.Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, trx := range txs {
+		if err := flow.Adopt(trx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.AddBlock(b, receipts); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetBestBlockID(b.Header().ID()); err != nil {
+		t.Fatal(err)
+	}
+	blockID = b.Header().ID()
+
+	router := mux.NewRouter()
+	debug.New(repo, stater, 50000000, thor.NoFork).Mount(router, "/debug")
+	ts = httptest.NewServer(router)
+}
+
+func newTx(t *testing.T, chainTag byte, nonce uint64, acc genesis.DevAccount, clauses ...*tx.Clause) *tx.Transaction {
+	builder := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(100).
+		Gas(100000).
+		Nonce(nonce)
+	for _, c := range clauses {
+		builder.Clause(c)
+	}
+	trx := builder.Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trx.WithSignature(sig)
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func httpPost(t *testing.T, url string, body interface{}) ([]byte, int) {
+	res, err := http.Post(url, "application/x-www-form-urlencoded", bytes.NewReader(mustMarshal(t, body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, res.StatusCode
+}
@@ -3,6 +3,7 @@ package debug
 import (
 	"fmt"
 
+	"github.com/vechain/thor/api/accounts"
 	"github.com/vechain/thor/thor"
 
 	"github.com/ethereum/go-ethereum/common/math"
@@ -14,6 +15,18 @@ type TracerOption struct {
 	Target string `json:"target"`
 }
 
+// TraceCallOption is the batch call to trace, with the name of the tracer.
+type TraceCallOption struct {
+	Name string `json:"name"`
+	accounts.BatchCallData
+}
+
+// TxTraceResult contains trace results of executed clauses of a tx.
+type TxTraceResult struct {
+	TxID    thor.Bytes32  `json:"txID"`
+	Clauses []interface{} `json:"clauses"`
+}
+
 type ExecutionResult struct {
 	Gas         uint64         `json:"gas"`
 	Failed      bool           `json:"failed"`
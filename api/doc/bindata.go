@@ -236,7 +236,7 @@ func swaggerUiSwaggerUiJs() (*asset, error) {
 	return a, nil
 }
 
-var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x93\xdb\xb8\xb1\xe8\xf7\xf9\x15\x28\xe7\xd6\x95\x37\x65\x6b\x40\xf0\x05\xce\xb7\xdd\xb5\x73\x32\x95\x3d\xb1\xaf\xd7\x37\x39\x55\xa7\x4e\x5d\xe1\xd1\x90\x18\x53\xa4\x42\x42\x33\x9a\xbb\x39\xff\xfd\x14\x00\x92\x22\x25\x8a\x92\x66\x34\x9b\xf1\xc6\xda\xaa\xad\x31\x89\x47\xa3\xbb\xd1\x2f\x34\x9a\xc5\x0a\x72\xb6\x4a\x6f\x90\x3f\xc5\x53\xef\x2a\xcd\x55\x71\x73\x85\x90\x4e\x75\x06\x37\xe8\xf3\xa2\x28\xa1\xd2\x57\x08\x49\xa8\x44\x99\xae\x74\x5a\xe4\x37\xe8\x1f\x57\x08\x21\xf4\xe9\xfd\xcf\x9f\xd5\x3a\x43\xdf\x7f\xbc\x45\xba\x40\x4c\x08\xa8\x2a\xf4\x17\xf8\x71\xc1\xd2\xdc\x76\x45\x7f\x06\x7d\x5f\x94\x5f\xae\x6c\xfb\xff\xfc\x58\x16\x7f\x03\xa1\xd1\x1f\x8b\x25\xfc\xd7\xeb\x85\xd6\xab\xea\xe6\xfa\x7a\x9e\xea\xc5\x9a\x4f\x45\xb1\xbc\xbe\x03\x61\xfa\x5e\xeb\x45\x51\x7e\x77\x85\x50\x96\x0a\xc8\x2b\xb8\xb1\xdd\x73\xb6\x84\x1b\xf4\xd3\xbf\x7d\xfc\xc9\xc0\x6a\x1f\xad\xcb\xec\x06\x4d\x9a\x81\xee\xef\xef\xa7\xf3\x7c\x3d\x2d\xca\xf9\x75\xdd\xb3\xba\xce\xe6\xab\xec\xad\x59\x1b\xe4\xd3\x85\x5e\x66\x93\x2b\x84\xee\xa0\xac\xec\x3a\xbc\xa9\x3f\x25\x57\x57\x15\x94\xe6\x91\x99\xe6\x6d\x3d\xe6\xf5\xc4\x4e\xd0\x5b\x75\x56\x08\x96\x21\x03\x1b\xca\x0b\x09\x57\x57\x9a\xcd\xeb\x4e\x0e\xb6\xef\x85\x28\xd6\xb9\xae\xf6\xbb\x7e\xef\x70\xe3\xb0\x64\xda\xa0\x82\x1b\x54\x54\x9d\xde\x9f\x4b\x96\x57\x4c\x98\x0e\xa3\x23\xe8\x7e\xbb\xa6\xfb\x0f\x59\x21\xbe\x8c\x76\xe4\x4d\x8b\xa6\xcb\x4f\xc5\x7c\xb4\x03\xdc\x41\xae\xd1\xff\x76\x33\x2a\x28\x51\xe6\x3a\x34\xfd\xff\x6c\xb0\x30\xd2\xdf\x60\x09\x55\x9a\xe9\x75\x85\x0c\x63\x75\xba\xfe\xbc\xe6\x6d\x97\x01\x18\xea\xd7\x1c\x50\x9a\x6b\x30\x2c\x08\x12\x55\xeb\x3d\x9c\xbd\x03\xbe\x9e\xef\x77\xb7\x8f\xd1\x5a\xa7\x59\xaa\x53\x70\xe3\x5f\xad\x98\x5e\x58\x72\x5d\xd7\x34\xa8\xae\x7f\x61\x52\x96\x50\x55\xff\xed\x38\x6c\xc5\x4a\xb6\x04\x5d\xb3\x82\xf9\xbd\x45\xff\xab\x04\x75\x83\x26\xbf\xbb\x16\xc5\x72\x55\xe4\x60\xba\x6d\xdb\x5d\x7f\xef\x06\xb8\xcd\x3f\x32\xbd\x98\x9c\xda\xeb\x13\xdc\xa5\x86\x03\x6f\xf3\xff\xb3\x86\xf2\xc1\xf5\x9b\x83\x6e\xa6\x6d\x18\xab\x19\xae\xc7\x58\x08\x55\xeb\xe5\x92\x95\x0f\x37\xe8\x13\xe8\x32\x85\x3b\x68\xb9\x4a\x82\x66\x69\x56\x37\x1b\xd8\xb2\xe6\x97\xe6\x22\x5b\x4b\xa8\xd0\x8c\xb3\x8c\xe5\x02\x66\x6f\xd0\x0c\x72\x28\xe7\x0f\x33\xc4\x72\x89\x66\x0b\x56\xfd\x58\x48\xf3\x9c\x3f\xb4\x43\xcf\x6a\x5c\xcd\xa6\xe8\xfb\xbc\x7d\x7a\x9f\xea\xc5\xb6\x03\xe2\x80\x7e\xaf\xcb\x35\xfc\x1e\xa5\x15\x62\x48\x14\xb9\x2e\x99\xd0\xd3\xab\x76\xf6\x3f\xa6\x95\x2e\xca\xd4\xec\xa4\x3e\xd0\x48\xb0\xdc\xf4\xff\xfb\x1a\xca\x14\xa4\x99\xba\x5a\x81\x48\xd5\x43\x9a\xcf\xd1\xac\xac\x51\x36\xb3\x0d\x1e\x50\xa5\xcb\x34\x9f\x4f\xeb\x71\x4b\xa8\x56\x85\xd9\xef\x5b\xac\x4d\x08\xc6\x93\xed\x3f\x77\xd0\xf1\xe1\x4f\x9d\x37\x06\x4c\xc8\x75\xb7\x31\x42\x6c\xb5\xca\x52\xc1\x4c\xf3\xeb\xbf\x55\x45\xde\x7f\x8b\x50\x25\x16\xb0\x64\xbb\x4f\xd1\x20\xe9\x5d\xdb\xea\xba\xa6\xe3\xc4\xa1\x63\x55\x54\xed\x9c\x12\x56\x25\x08\xa6\x41\xde\x20\x83\xc0\x33\x19\xe1\xfd\x06\xc4\x5a\x6f\xf9\x40\x34\x3b\xf3\x20\x17\xe8\x02\x55\xe9\x72\x9d\x31\x0d\x2d\x99\xd0\x12\xf4\xa2\x90\x48\xb0\x2c\x7b\x63\x49\x5b\xac\x35\xaa\x20\x97\x86\x04\x1d\xb9\xd3\x4a\x13\x64\xe5\xf5\xb4\x1d\xb5\xfd\xe3\x56\x4f\x2a\xb4\xae\xc0\xe8\x07\x23\x49\x2a\x9d\x2e\xcd\x54\x73\x66\x1e\xb3\x39\x58\x4e\x03\x0b\xb6\x19\xb0\x84\x6a\x9d\x69\x54\x28\xc3\x35\x19\x5b\x57\xb0\x25\xed\xdf\xd7\x50\xe9\x1f\x0a\xf9\xb0\xc5\x44\x6f\x51\xac\x9c\xaf\x97\x06\xcf\x6e\xcc\xfc\x2e\x2d\x8b\xdc\x3c\x68\x9b\x9b\x31\xd2\x72\x07\xb7\x83\x74\x1f\xa7\xfa\x30\xcd\xc7\x28\xfe\x23\xcb\xb2\x77\x4c\xb3\xc9\xd7\xc5\xa8\x06\xec\x4f\x96\x24\x93\x9e\xc0\xfc\xfd\xcd\x1e\xe7\xee\x0b\xcd\xc7\x0a\xc0\x47\xb0\x3b\xe2\x4c\x8b\x85\x61\x1b\xc3\xf1\xd5\xe9\x2c\xbf\xe5\x3c\xcb\x72\x1d\xde\xfe\x6d\xf0\xdd\x0f\x06\x2f\x5f\x29\xf3\xb5\xb0\x37\x1c\xd8\x65\xc1\x7d\x06\x3c\x20\x3a\xff\x99\x7c\xc9\x1f\x34\x9c\xc9\x90\xad\x0c\x96\xb0\xca\x8a\x07\xc3\x46\xbf\x86\x04\x1e\x9a\xf6\xb0\x2c\xee\x0c\xff\xbb\xdf\xfd\x0e\x7d\xbe\xfd\xf8\x73\x97\xb4\x6f\xd1\x4c\x32\xcd\x66\x28\xcd\x9b\xed\x83\x78\x21\x1f\x8c\x31\xa0\x17\x1d\xb4\xd4\x63\xd7\x73\x1f\x1c\xc1\x71\x6b\x6f\x88\x72\x9d\xeb\x74\xd9\x1d\x8a\x55\x55\x3a\xcf\x41\x76\x8d\xeb\xfb\x45\x2a\x16\xb6\x7d\xbb\x3e\x83\x2f\xa8\x57\x09\xf2\x37\xb1\xc7\xbf\xd2\xed\x7d\x68\x67\x6f\xad\xf1\x6b\x43\xd9\xdf\x8a\x49\x7e\xdc\x14\x4b\x15\x62\xf9\xc3\x14\xfd\x11\x4a\xa8\x99\x56\x82\x61\xf8\x3d\x66\xff\xca\xcc\x5d\xe3\x13\x1c\xa4\xb1\x71\x03\xd8\x1c\xae\x7f\xf9\x02\x0f\xbf\xb6\xff\xf5\xb3\x9b\xfb\x4f\xf0\xf0\x52\xb8\xa4\xc6\x06\xba\x63\xd9\xfa\x08\xbb\xa8\xa2\x44\xf3\xf4\x0e\x72\xf4\x05\x1e\xbe\x32\x8e\xa8\x11\xef\x98\xa2\x1b\xc8\xb8\xfe\x25\x95\x8f\xe7\x82\xcf\x9b\xdb\x77\xe7\x52\x92\xdd\xef\x28\xf9\xa3\x5d\xfe\x08\x4c\x9e\xdb\xe7\xa3\x53\xdd\xa7\xf2\xcb\x5e\x0c\x68\x88\x67\x3a\x78\x1b\xe7\x14\xfe\x80\x6e\xdf\x4d\xd1\x5f\x17\x90\xa3\xd9\xca\x41\x32\xb3\x9a\xb4\x5c\xc3\x1b\xc4\xd0\xaa\x31\x2c\x36\xce\x91\xcf\xd7\x59\x86\x66\x4b\x30\x1a\x78\x99\xce\x17\xda\xe8\xcc\x12\xf4\xba\xcc\x41\xbe\x40\x56\x2b\x72\xf8\xa0\xf6\x1f\x1b\x4c\xb2\x2c\x1b\x7e\x75\x88\x68\x0d\x8b\x7e\xde\x4c\x06\x7b\xad\xca\x62\x05\xa5\x4e\xbb\xeb\xee\xff\x0c\xde\x0e\xbd\xeb\xda\x09\x8a\x65\x15\x1c\x6c\x37\x0e\xdb\xbf\xc3\x56\xdf\x5f\x68\xc1\x9f\xd8\xfd\xd7\xb9\xe6\x1d\x36\x2b\xd9\xfd\xc0\xd6\xd8\xfe\x60\xc3\x96\xab\x0c\x86\xa0\x4d\xe5\x0d\x9a\xe0\x4d\x20\x21\xf6\x14\x91\x21\xa5\x8c\x51\xe6\x01\xc3\x58\x01\xf5\x3d\x22\x13\x92\x44\x91\x64\x01\x09\x64\x92\xf8\x09\x0b\x3d\x4f\x09\xcc\x81\x7a\x10\x85\x8a\xc9\x90\x30\x45\x87\x80\xb4\xe6\xf9\x67\x36\xbf\x41\xde\xc0\x5b\x6b\xc2\x7f\xb2\x8b\xc7\x1b\xec\x7e\x5e\x33\xf6\xd0\x70\xb0\x59\xa5\x25\x73\x0b\xf6\xf1\xd0\x7c\xd6\x60\xaf\x6e\xd0\x7f\xfe\xd7\xc0\xdb\x39\xab\x3e\x96\xa9\x80\x1f\x0b\x33\xa7\x47\xe8\x70\x9b\x1b\x44\x3c\x8c\x87\x86\x2f\xca\x74\x9e\xe6\x16\xdc\x38\x8c\x62\x49\x7d\x1e\x73\x2a\x29\x66\x52\x0a\x4e\xa8\xc7\x62\x4f\x86\x81\x12\x31\xf7\xfd\x28\x50\x0a\xe4\xd0\x32\x24\x64\x30\x67\xba\x28\x6f\xac\xcc\x19\x68\x91\x17\xb9\x00\x3b\xcf\x2e\xee\x87\xc7\x33\xa2\xac\xfa\x90\x1f\x1c\xaf\x4a\xff\x3f\xdc\x20\x8f\x0e\x2d\xea\x30\x13\x5b\xfa\xdc\xbe\xeb\x91\x47\x04\x21\x4d\x82\x24\xa1\x21\x8b\x24\x8d\x78\xec\xf9\x49\x94\x60\x4e\xa9\xe7\x49\xe9\xf3\x20\x0a\x62\x81\x89\x0c\x54\xe0\x09\x09\x8a\xc7\xd2\x27\x3e\x89\x87\x59\xd8\xce\xf0\xe7\xf5\x92\x43\x39\xcc\x22\x75\x93\xcf\xe9\x12\x2a\xcd\x96\xab\x1b\xe4\x85\xc4\xf7\xc2\x88\xc4\xde\xb0\x1a\xbd\x2e\x41\x40\xba\xd2\xbf\xa6\x3a\xdd\xd3\x8d\x17\x54\x72\xa8\x5e\xcf\x29\xca\xee\xe5\xe9\xa8\x83\x72\xf9\x88\x54\x76\x6b\xde\x67\x9a\x8e\x4c\xee\x3e\x3e\x8b\xad\x4f\x98\xd8\x09\xdd\x5d\xfe\xda\x8f\xbe\x9c\x43\xdc\x1f\x8b\xe5\x32\xd5\xa7\xdb\x2f\x69\x6e\x84\xfa\x68\x40\xee\x9f\xe7\x7d\xf7\xd4\xe6\x0b\xe2\xb7\x51\x5d\xfa\x1f\xb7\xef\x1c\x51\xdd\x59\xe0\xf5\x2f\xcd\xb1\xca\xe3\x6d\xef\xad\x4b\x74\x96\xc0\x78\xbf\x59\xb1\x5c\xc2\xc9\x42\xa3\x73\xbc\x39\x24\x2e\xec\x7a\x4e\x10\x10\xa8\x28\x51\x6e\xa5\xed\x1b\xf3\xe7\x84\x43\xa5\x27\xd6\xa5\xca\x98\xb6\xf1\x2a\x33\xd0\x14\xdd\x2a\x34\x83\x1a\xc4\xe6\xc8\xa9\xb0\x43\x76\xec\xe7\x2c\xeb\x1d\xc6\x22\x96\x15\xf9\xdc\x5a\xd2\xed\xa4\x7a\x01\x69\xd9\x08\xb0\x0a\xdd\xa7\x59\x66\x6c\x6a\x58\x72\x90\x12\x24\x5a\xe7\x12\x4a\x34\xeb\x0e\x33\x43\x2a\x85\x4c\xa2\x34\xaf\x34\x30\x89\x0a\x85\x52\xf9\x12\x5d\xff\xe7\xb0\xbe\x2d\x99\x87\x35\xe5\x78\xc7\xdb\xea\x73\xb9\xce\x0f\x74\x3d\x6e\xc7\xee\x0b\xb9\xa1\x5f\x0f\xb1\x5d\x0d\x75\xfb\xae\x3a\xd4\x67\x50\x34\xd7\x93\x3e\xac\xe0\x06\xb1\xb2\x64\x0f\x07\xdb\xa4\x1a\x96\x23\x10\x35\x83\xb8\xe3\xd0\x91\x66\x8d\xf5\x6b\x2c\x19\x42\x03\xce\x59\x88\x41\xc5\x71\x4c\x69\xa2\x94\xc7\xfc\x28\x06\x89\xb9\x4f\x65\x08\x61\x44\xa2\xd8\x0b\x82\x38\x16\x01\x96\xe0\x53\x19\x7b\x02\xa4\x8c\x54\xa2\x58\x10\x0f\x5a\x32\xff\x1a\x34\x6f\xf7\xed\x81\x7d\xbf\xb3\xdf\x9f\x97\xf2\x23\x08\x6f\x7e\x8f\x73\x75\x4f\xeb\x7d\xd0\x42\x41\x7b\x58\xab\x05\x69\x2d\xa5\x77\x3a\x1c\xf4\xcb\xf2\xda\x2a\xf6\x49\xe8\x93\x60\xef\x75\xed\xb4\x61\x8c\x03\x15\x09\x41\x29\xe7\x41\x44\x22\x96\x90\x04\xc7\xb1\x47\x81\x12\x45\xc2\x90\x53\x65\xbc\xb5\x20\xf4\x59\x4c\x81\xc6\x49\x0c\x9c\x0a\x60\xbe\x9f\xf8\x9c\x78\xe1\x3e\xfc\xce\x55\xf0\x63\x7f\xdf\xf6\x62\x25\xe4\x7a\xeb\x0f\x98\x89\x79\xec\x63\xc9\x65\x82\x15\x48\x9c\x48\x2f\x0a\xb9\x92\xca\xf7\x85\xc0\x00\x32\x88\x41\xe0\x88\x26\x3e\x55\x11\x40\xcc\x63\xe1\x11\x16\x00\x4b\xe8\x80\x5f\xa4\xbb\x36\xbe\xef\x93\x28\x4e\x06\x9c\xb0\x39\xab\x7e\x4a\x97\xa9\xbe\x41\x9e\x47\x42\x3f\x8c\x93\xbd\x26\x1c\x72\x50\xa9\x48\xad\x8e\x9c\xe0\x0d\x0f\x70\x12\x08\x12\x2a\x1a\xc9\x88\x50\x25\x65\x18\x7b\x4c\x89\x00\xc7\xb1\xc2\x12\x7b\x49\xc4\x14\x0f\x06\x1c\xd8\x39\xab\xfe\x6f\x65\xcc\xab\x61\x87\x50\x17\x9a\x65\x3f\x8b\xa2\x34\xbe\x15\x26\x49\x42\xf7\x3d\x4a\xbd\xa9\x3e\x15\x85\xb6\x80\xd0\x44\x2a\x99\x28\x21\x3d\x2c\x12\x08\x7d\x19\xd1\x30\x21\x42\x51\x1e\x06\x98\x13\x8a\x79\x4c\xa4\x4f\x3d\x4e\x23\x1a\x12\x9f\x10\x3f\x49\x88\xf2\x01\x27\x8c\xe2\x88\xf3\x01\x9c\x6d\xaa\x3f\x00\xd3\xeb\xd2\xd8\xc3\xfb\x00\x56\x9a\x69\xd8\x4e\x1f\x71\x21\x22\x49\xbc\x80\x8b\x44\x52\x89\x25\x48\xce\x3c\xec\x11\x16\xf9\x82\xfa\x5e\x2c\xbd\x44\x40\x12\xab\x08\x0b\xca\x08\xa8\x50\x84\x09\xe7\x32\xc0\x32\x20\x91\xb7\x3f\x7d\xb3\xd3\xdb\x29\xbc\x30\xa6\x31\x90\xd0\xf7\x45\x10\x63\xa0\x2c\xa2\x14\x22\x21\xbd\x98\x79\x00\x1e\x91\x34\x08\x8d\xd4\x95\xa1\xa2\x44\x12\xe1\xe1\x04\x88\x8c\x08\x89\x24\x85\x30\x18\x70\xfa\xed\xf1\x56\x69\x07\x67\x3c\xe6\x24\x56\x22\x81\x58\x92\x44\x25\x8a\x40\xc8\xa5\x1f\x79\x71\x10\xb3\x30\xf4\x42\x89\x85\x20\x72\x00\xce\xd4\x89\xca\x1d\x33\xb9\x45\xe2\x11\x49\xf8\xf6\x32\x5a\xc3\x18\x9e\x59\x31\xaf\xae\x6d\x62\xd9\x71\x5f\xa2\xcd\x4f\xeb\x58\x7c\x7f\x48\x33\x0d\x65\x9d\x9a\x96\x6d\x1b\x1c\x30\xfa\xde\xb7\xed\x10\x2b\xc1\x28\x05\xb9\x16\x2e\xbb\x68\xf6\xe1\xe3\xff\xfb\xe9\xc3\xbf\xd9\xb3\xc6\xf7\x7f\xf9\xf7\x17\xea\x66\xd8\x05\xb8\x45\xbf\x40\x67\x63\x4c\x8f\x1d\xd4\x5f\x8f\x36\x14\x2c\x2e\x86\xf4\xcd\x31\x5d\x3f\x16\xa5\x1c\x9b\xf0\xa7\x62\xbe\xf5\x83\x2d\xe7\x36\xa9\x90\x4f\x62\xde\xdd\x7c\x4a\x74\x98\x7f\x3f\x77\x9b\x5a\x16\x2e\x41\x14\xa5\x51\xa6\x45\x8e\xfe\xf2\xfe\x73\x3b\x58\x3f\x1d\xee\x45\xf1\x70\xb3\x88\x6f\x6c\xdc\x43\xc7\x3f\x8d\x93\xf3\x42\xc2\x75\xee\x52\xb3\xaf\x57\xd0\x7a\xfb\x23\xee\xf7\x9f\xb7\xa7\xd8\xfb\xce\xb7\x28\xf2\x1c\x84\x06\x89\xec\x60\x2f\x8f\xbe\x07\x69\x38\x86\xb2\x8f\x00\xe5\xcf\x9a\xe9\xca\x21\xad\xea\x66\x2c\xbb\xf8\xc9\x51\xac\xed\x67\x39\x77\xd0\xf7\xfa\xaf\xc0\xab\x42\x7c\x01\xfd\x5d\x27\xdf\x39\x87\xfb\x6d\xa2\x36\x7a\x6c\x1e\xd3\xc7\xa2\x4a\xf5\x7e\x1e\xd3\xcb\xa3\xcc\x23\xa3\xa3\x07\x5d\xc6\xf1\x6e\x1f\x78\x55\x64\xa0\x07\x8c\xac\xf1\x7d\x77\xdc\x53\x1c\x8b\x0c\x0c\x76\x38\xe6\x01\x8e\x7a\x7f\x27\xf8\xfc\x97\xf5\xf7\xf7\x37\x40\xc7\x84\xbb\xfc\x06\xb0\x83\x1f\xd1\x8c\x2e\xc7\xab\x62\x3a\xad\xd4\x03\x12\x65\xaa\xa1\x4c\x99\x31\xe6\x6c\xb4\x6e\x2f\x65\xed\x92\xfb\x68\x7b\x01\x81\x49\x59\x76\x28\x90\xe6\x37\x6e\xfa\xce\xb3\x21\xd6\x3f\x40\xc0\x7e\xea\x99\x4b\x6d\x41\x85\xaa\xad\x5d\x58\xa6\x5a\x43\xb9\x07\x83\xc6\xcf\x04\x81\x2e\x56\xa9\xc0\x2d\x00\xfb\x13\x7b\xcf\x39\xb1\x37\x32\x31\x79\xce\x89\xc9\xc8\xc4\xfe\x73\x4e\xec\x8f\x4c\x1c\x3c\xe7\xc4\xc1\xee\xc4\x5f\xbf\x86\x38\xe8\x2b\x3c\x8f\x86\x78\xdc\x59\x5b\x6b\x95\xed\x76\xba\xea\xfd\xb9\x23\x7a\xfb\x3e\xc8\xe5\xa5\x6f\x33\xfe\x65\x04\xf0\xf3\xc8\x5d\xbd\xf9\x60\x33\x11\x9e\x69\x57\xb8\x98\x4b\x57\x04\xeb\x4d\xbd\x60\xc3\xdc\x2c\xcd\x5d\x3a\x72\x83\xaa\x3d\xf8\x2a\xc8\x25\xfc\x0a\x9a\x41\x17\x5f\x20\xdf\x9d\xad\x01\xa2\x04\x91\xae\xd2\xae\x38\x79\x66\x38\x76\x27\xfc\x1a\xc4\xc8\x53\xbc\xb5\x17\x2a\x4d\x06\xdc\x15\x60\xcf\x62\xac\x75\xae\x20\x4c\x2a\x64\x66\x39\x49\x68\xd4\x7b\xa8\x19\xdd\x30\xd0\xd6\xef\x79\x63\x53\xed\x79\x56\x14\x4b\xa4\x6c\xc4\xc0\xec\x35\xa6\x91\x5d\x72\x95\x1a\x3f\xd3\x9e\xb5\x30\xa5\x9c\xd7\x59\xf3\xe1\x36\x3f\xfa\x92\x32\xe7\xb7\xc0\xc3\x3f\x00\x3b\x70\x4e\x73\x2a\xff\x1a\x96\x92\xc0\xd7\x73\xa3\x7d\x44\x8b\xd8\xb1\x00\xd8\xf6\x6e\x6e\x37\x05\xa4\x04\x66\x2f\x6e\xb9\x61\x06\x98\xa5\x1d\x41\x15\x65\x7b\x01\xa5\xa5\xc5\x4b\x8b\x6b\x09\x28\x3f\x58\xb8\x27\xae\xf9\xd5\xcb\xe3\x1a\x27\xc9\xdd\x7d\xf3\x0e\x1d\xeb\x8c\xf3\xb7\x25\xcb\xe7\xf0\x48\x6a\xb6\x11\xa0\x26\x7d\xdd\x0e\x36\x2e\x01\x8c\xb2\x58\x40\xff\x02\xb1\x4b\x67\xaf\xb7\xf1\xcb\xa4\x75\x9d\xb9\xfe\xc9\x2c\xb0\xa6\xf8\xcb\x23\xf5\xa9\x0b\x98\x5c\x5d\x6d\x5b\x98\x61\xea\x46\x6e\xc4\xfa\xd2\x42\x33\xfc\x90\xaa\xaa\x6f\x8e\x77\x21\x38\xc5\x60\xa8\xbb\x19\x1b\x71\x9d\xa7\x1a\xfd\xf5\xfd\xed\x1b\xb4\x2a\xa1\x82\xbc\x95\xea\x0b\xd8\xec\x8f\xd2\x8d\x66\x04\xb1\x52\x9e\x4a\xb0\x4f\x62\xc6\xb0\xa2\x1d\xed\xea\x6e\xb1\x9f\x0b\x95\xeb\x65\x81\x4a\xf3\x47\x02\x25\x54\x44\x02\x2f\xa4\x32\x4c\x3c\x3f\xe9\x1c\xa4\xd6\x57\xe3\xf7\x61\xe2\x45\x91\x01\xcb\x0f\x01\x75\xbf\x00\xbd\x80\xb2\xb7\x57\x16\xac\xea\x5e\x27\xea\xc1\xe0\x12\xac\xed\x9b\xee\x7c\x43\xc4\x13\x83\xf0\x8c\x2e\x2f\xc2\xe6\xbf\x00\x87\x24\xc2\x18\x53\xac\x24\xc6\xcc\x8b\xc2\x88\xc4\x2c\x66\x31\xf1\x71\x48\x09\x16\xc4\x97\x3e\x03\x22\x05\x8d\x98\xf4\x7c\x1c\x46\x1e\x23\x94\x24\x92\xc6\x22\x16\x9c\x06\x7e\xe8\x47\x61\x90\x10\x2e\xbd\x30\xa0\xc0\x63\x88\x95\xc0\xca\x8f\x7c\xc2\x21\xc1\x98\x24\xf5\xdd\xf8\x9a\x5b\xc7\x96\x61\xaf\xca\x9c\xb9\x0e\xfc\xb4\x9f\x57\x43\xe7\x72\xd2\x6f\x86\x04\x5d\x37\xf6\x67\xcc\xb8\xa6\xf0\xc5\xa1\x55\x34\x19\xc6\xe7\xee\x24\x6b\x78\xa5\x12\x72\x9d\xaa\x14\x4a\xf4\x9a\x3f\x68\xa8\x7c\xf2\xdd\xe1\x95\x5f\x28\x4d\xa2\x9b\xb1\xbc\x07\x75\x9a\x6b\x98\xf7\x1c\x1f\x55\x94\x4b\xa6\x6f\xec\xde\xf2\xc9\xf8\x7a\x5c\xc6\x07\x7a\xbd\x80\x74\xbe\xd0\x83\x4b\xd9\x49\x06\xd9\xc9\x8d\x3e\x13\x9e\x28\x18\x87\x67\x9d\xa7\x9b\x6d\x56\xc6\x10\x38\x9d\x3c\x0d\xfb\xba\x93\x3d\x3b\xcc\x1e\x9b\x26\x65\xe0\x1b\x77\xfc\x4b\x71\x47\x3b\xf1\xe6\x7c\x72\x76\x65\xca\x96\xa8\x07\x48\x79\xb9\xe4\xbe\x26\xc6\xf2\x14\x70\xdd\x7d\x11\xf4\xda\x05\x54\x0e\xb1\x9f\xe4\x01\x26\x71\x10\xc7\x9c\x30\xaa\x20\x10\xd4\x17\x91\x64\x0a\x62\x45\xa3\x28\xa6\x9c\x7b\x9c\x32\x2a\x6b\xf1\x5b\x3b\xba\x83\x1b\xcc\x85\xca\x8b\xfe\x21\xfb\xb7\xbd\xb6\xcb\xdb\xdf\xf6\xda\x09\xcc\xfb\x2f\xbe\xd7\x9a\xde\xce\x05\xbf\xcd\x25\x6c\x2e\xc7\x66\xa9\x19\xce\x16\x6e\xb1\xa3\xd7\x81\xa1\xb9\xb1\xc5\x99\x86\x0a\xe9\x45\x5a\x99\xad\x3b\xb4\x8a\x5a\xd7\xfe\xb0\x3d\x83\x1f\xde\xd1\xf9\x0b\xd9\x1a\xa9\x3c\x81\xac\x0d\x08\xb5\xf4\x38\x55\xdc\x3c\xbb\x90\xb1\xd9\xb0\x17\x43\xe1\xa7\x9f\x3e\x22\xc8\x8d\x07\x52\x67\x03\xdb\xf1\x8d\xef\x65\xd7\x3d\x88\xcc\x4e\x22\x6e\x9b\x80\x7b\x31\x7c\xba\x11\x6b\x58\x6e\xdf\x8d\xa3\xf3\x02\xb9\xbe\xfa\x45\x49\xc8\x36\x97\xf8\xc2\xc0\xcc\x59\x85\x32\x33\x30\x7a\xbd\x64\x1b\xc4\xb2\xac\xb8\x07\x69\x1c\xd9\xb5\xad\x77\x93\xde\x75\x0b\xd1\x14\xaa\x97\x61\x31\xb8\xa5\xf6\x72\x9d\xbb\x39\xce\x17\xe3\x86\xce\x89\x46\xe3\x74\xeb\xc2\x59\xec\xcd\x25\x23\x54\xc2\x3d\x2b\xe5\x01\x46\x39\x3f\xd3\xba\xc9\xb0\xbe\x18\x05\x4e\x43\xf2\x10\xfc\xfd\x1c\xef\x4e\x6e\xf7\xc5\x60\xab\xd6\x4b\x8b\xdb\x2c\x43\x2c\x17\x50\xe9\x92\x65\x75\xd8\x7f\x82\x2a\x33\xd7\x20\xed\x77\x32\xcb\x9b\x8c\xf2\x8b\x91\xbd\x2c\x0a\x1b\x5d\x59\xec\x62\xc9\x08\x26\x5b\xb9\xc8\x52\xfe\x00\xcd\x2f\x97\xd4\xde\x4d\x66\xbf\x98\xc8\xad\xd6\xab\x55\x51\x6a\x90\x66\x78\xa4\xea\xf1\x11\x4f\x75\x05\x7a\x58\xbd\x36\x8f\xb6\xd9\xf3\xcf\x83\xea\xa6\x2c\x8c\x9b\xe8\x10\x7a\x2f\x96\xb4\xdf\x4b\xd6\x7f\x76\xe6\x19\xba\x05\xd4\x5d\xd7\xe5\x6e\x0a\xd4\x37\x04\xce\x5c\x11\xc1\x07\x8d\xca\x05\xa0\x22\x37\x36\x59\x81\x9a\xe2\x5a\xc6\x1c\xeb\x5e\xb2\xdc\x5d\xcd\xe9\x57\x13\xec\x00\x3f\x5a\xab\x6f\xcc\x78\xd3\xc5\xb9\xb6\xf0\xa4\x3d\x7e\xde\xda\x95\x6f\x5c\x4d\x13\x55\x94\x83\xa5\xce\x5a\x5f\x6d\x72\x60\x59\x21\xf6\x03\xc6\xc2\x04\x7b\x24\xe4\x51\x80\x89\xcf\x30\x89\x88\xe7\x11\x9e\x50\x19\x13\xf0\x05\x85\x00\x77\x88\x71\x6a\x58\xb2\x07\xfa\x02\x36\x96\x38\xdb\xa3\x74\x57\xaf\xac\x4d\xee\x86\x43\x0a\x27\x88\x95\xe4\xbe\xf0\x55\x10\x46\x02\x63\x8c\xb7\x90\x48\xd6\x3f\x45\x3e\x05\x90\x34\x5f\xad\xb5\xed\x59\xe3\xe6\x90\x1b\xd1\x46\x42\xc7\x68\x78\x92\xe1\xdb\x9f\x7f\xeb\x47\xd7\x07\x45\xc3\x25\x3d\x9e\xc7\x0b\x2b\x1e\xe7\x83\x0d\x6d\x97\x53\x00\x3f\xdf\x15\xdb\xd6\xcd\x78\x04\x8c\x6d\x67\x0b\xe9\x8a\xa5\x0e\x4e\x63\x22\x28\x18\x94\xbe\xbd\x5a\x1a\x97\x75\x04\x0c\x73\x39\xdb\x7f\x9f\xce\xee\xb8\x3f\xad\xba\xde\xc2\xa0\x5d\xd0\xa9\x80\xd2\xd6\x59\x39\x13\x42\x7a\x08\xc0\x8c\x55\xda\x41\x59\x28\xeb\x97\x56\x8d\x04\x3c\xe0\x26\xf8\x49\x3f\x16\xf2\x09\xd4\xb9\x54\xa2\x4e\x32\xa3\x55\x09\x2a\xb5\xde\x71\x55\x2c\xe1\x5c\xe7\xa4\x73\x1e\xb6\xad\x16\x73\x31\xc2\x4d\xb6\x83\xa2\x12\x6a\x33\xb3\xa9\x46\xf9\x09\xd4\x9b\xf6\x74\x8f\xef\x26\x69\xb7\x40\xc7\x1d\xdd\xd3\x14\xac\xd9\x03\x70\x37\x37\x7a\x20\x23\x7a\xb4\xf8\x9c\x1d\xb7\x67\x67\x6f\x4b\xdf\x5c\x8a\x49\x44\x01\xca\x38\x21\x46\x97\xac\x2b\x57\x84\x52\xb0\x4c\xb8\x9a\x9e\x86\xad\x55\x9a\xb3\xcc\xee\xb1\x95\x99\x7d\xdc\xde\x9a\xb3\xb3\xed\xbe\xc3\xb6\xb6\x75\xbc\x96\xae\xd8\xbc\xb2\x10\xd4\x15\xb6\x45\x91\x57\xeb\xa5\x03\x16\xea\x8a\xa5\x56\xbf\x1f\x91\x58\x7d\xf7\x60\x5b\x72\xe7\x62\x96\xd4\xed\xbb\x21\x61\x50\xe4\xdd\x7a\x9e\xeb\xd2\xfa\xeb\xdd\x06\x35\x24\xa8\xc8\xa7\xcd\x12\x8d\xe0\x9a\x1e\x95\x68\xae\xc6\xd0\x71\xf0\x7b\xca\x26\x11\x24\x8c\xc1\x8f\x80\x45\x10\x13\x36\xb9\xaa\x0f\x7e\xd8\xfd\xb8\x2e\x2c\xd9\xfd\x53\xac\x82\x26\x68\x72\x5c\xab\x28\x1a\x25\xd4\xe3\x8c\x62\xcc\x24\x93\x49\x12\x9c\x72\xb4\x19\x07\x91\xa2\x84\xc4\x1e\xa6\x18\x7b\x94\x84\x04\x53\xf3\x97\xc0\x9c\x06\x5e\x10\x27\x44\x24\x81\x9f\x84\x49\x80\x13\xea\x13\x3f\xc1\x18\xa2\x20\xc6\x71\x40\x84\xa4\x71\x0c\x22\x51\x49\x82\x23\x2e\x18\x0e\x43\x0f\x43\x40\x3c\xe5\x73\xec\xf9\x20\x09\xf1\x7c\x12\x40\x1c\x0b\xe6\x61\xe9\x07\x51\xc4\x7d\xc2\x3d\x8a\xb1\x88\x09\x78\x24\xf6\x12\x4e\x3c\x5f\x79\x32\x10\x7e\x8c\x7d\x1c\xfa\x49\x22\x25\x89\x99\x4a\x22\x12\x91\x28\x30\x66\x8d\x5d\xee\xfb\x3b\x18\xcf\x4c\xa8\x3d\xf8\xc7\xe8\xc7\x8e\xf3\xdf\xda\x8a\x8e\xf3\xea\x6b\xa3\x2e\xe7\xd3\x9d\x30\xbc\xae\x6d\xe8\x43\xf6\xd1\xf9\xd5\xb1\x6c\x26\xf6\xe3\xe4\xe0\xc1\xfb\x20\x3d\x4b\xf1\x62\xd5\xcd\x4e\x34\x2c\x2f\x3b\xb9\x33\x37\x7b\xa9\xcf\xc3\x1c\xe0\x92\x61\xcf\x65\x80\x86\xf8\xd6\xf4\xa8\xac\x3c\xb1\x86\xf8\x21\xef\xed\x7c\xdb\xad\xf5\x4e\x9e\x04\x5a\x1d\x8b\x3a\x02\xdd\xf9\x6e\x8b\xd3\x14\x67\x83\xd6\xea\x97\x51\x70\x06\x9c\x14\x27\x34\xbb\x85\xca\x86\xa9\x79\x89\xf0\xd8\x01\x0d\x66\x2c\x02\xf6\xf0\x78\x56\xe9\x04\x09\x5b\x83\xda\x1a\x01\x73\x76\x39\xae\x31\xa3\x3e\x45\x6f\x6c\x29\x64\xe1\x73\xb9\x4e\x87\x22\x12\xc4\x8f\x40\x09\x2e\x38\xf7\x83\xbe\x2f\xe9\x82\x9e\x97\x01\x64\x34\x80\x1a\xc6\x11\x78\x34\x51\xc6\xa4\xdd\x05\xe1\x0e\x4a\x3d\xc4\x0a\x47\x52\xa9\x74\xb9\x06\xb4\x04\xd6\xcd\xd9\xaf\x4d\x87\x7b\x56\xb5\xe3\x0e\x01\xd4\x2f\x5b\x59\xac\xf5\x6a\xad\x1f\x27\xa2\x0f\x27\x7c\x37\xba\xe6\xfb\x7d\xcd\x35\x8a\xe9\x81\x95\xfe\x63\xef\x35\x6a\x4b\x9e\x6f\x75\x5a\xcd\xbf\x6f\x50\xaa\xea\x72\xd3\xa5\xcb\x61\xb4\xb5\x60\xeb\xf3\xb8\xb4\x42\x6c\x60\xb4\xa1\x20\x4a\x2f\x45\x77\x00\x89\x03\x15\x19\xdd\x05\xbf\x43\x4b\x3d\xf3\x22\xf6\xd9\xd7\x80\xda\x0b\x2e\xbf\x02\x00\xdb\xeb\x03\xb6\x63\x53\xb3\xfd\x12\x89\x6d\x63\x92\x78\x24\x7e\xf4\xc4\xb0\x50\x2f\x94\x26\x58\x87\xb4\x97\xf7\x5e\xea\x63\x23\x1b\xa1\x28\xca\xed\x47\x3b\xf6\x9c\xba\xb3\xb1\xc5\xab\x22\x5b\xd7\x5f\x43\xe8\x3b\x66\x66\x49\xe7\xeb\x04\xd7\xab\x55\x0d\xaf\x97\xd5\x7c\xea\x0c\x91\xc6\x40\xdc\x2b\x73\xe4\xc8\x6c\xb5\x02\x60\x1e\x71\x9f\xc5\x51\x30\x10\xc1\xb3\x52\x31\x8a\xc2\xc0\x8f\x68\xe4\x45\x49\x04\x04\x87\x41\x44\x23\x15\x93\x0e\x57\xb9\x92\xfa\x63\x7c\xf5\x18\xc2\xdb\xd8\x96\x15\x7b\xb6\xfb\x21\xc5\x81\xfd\x30\x8c\x58\xec\x0b\x0f\x83\x4f\x95\x02\xa2\x84\x31\x40\xb0\x12\x89\x0c\x22\x26\xb1\x17\x50\x85\x63\x20\x51\xe0\xc5\xe0\x79\x31\x97\x1e\x08\x48\x64\x12\x50\xde\x39\x6d\xde\x17\x0c\x17\x09\x06\xec\x88\x81\x41\x01\x70\x91\x89\xf6\x6f\x0b\x5d\xfc\x7c\xcf\x1d\xe9\x81\x44\x72\x6d\x28\x37\xb0\x2b\x0e\x5a\x3c\xe7\xa8\xd0\x03\x3a\xf0\x6e\xf9\xbe\x2c\x4f\x8a\x3f\x6e\x19\xa4\xe6\xd2\xde\x37\x69\x46\x13\x94\x7f\xbd\x90\xd0\x37\x81\x75\x50\x60\x59\xda\xdc\x81\xfc\x6b\x51\x7e\x39\x5b\x6c\x6c\xea\xce\xe8\xbe\x28\xbf\xbc\x76\xb8\xd0\x90\x57\xc6\xe8\x6a\xb4\xc7\x77\x4f\xb6\xc4\x2d\x32\x4c\xc7\xa3\x33\x3c\x47\x24\x54\x6f\x3a\xc3\x1e\x85\xe0\xb1\x31\xe1\x26\xe9\x40\x41\x09\xb9\x80\x23\xf3\xec\x69\x99\x81\xbd\xf4\x16\xe9\xe2\x91\x5e\x22\x3a\x4d\x6f\xa1\x93\x74\x17\xea\x6d\x44\x14\xe2\x5d\xe7\xcc\x6e\x14\x34\xf1\x76\x42\x55\x93\x5d\xd6\x7f\x5c\xbc\xa5\xc3\xdd\x6e\x8e\xc9\x3e\x3f\xda\x55\xfa\x0c\x62\x4a\x08\xe1\xc0\x24\xc7\x3e\x25\xd8\xe7\x40\x3c\x90\xa1\x80\x58\x24\xdc\xe3\x4a\x45\x98\x0c\x86\xdd\x51\x4f\xfe\x0e\x95\x7b\xc7\x34\xf4\x04\x53\xbe\xd8\xf6\xef\x4b\xcb\xbe\x62\xdf\x17\x84\x3b\x42\xf0\xe4\x4f\xef\xd8\x1e\xae\x24\x93\xbb\xd0\x54\x8d\xc9\xe4\x42\xa9\x0a\x4e\xca\x13\x1a\x88\x6b\x8f\xba\x29\x6e\x64\x94\xe6\x68\x69\x96\x0c\xb2\x2e\x71\x85\xba\xe9\x09\xd9\xa9\x59\x4a\x9d\xa4\x91\xd3\xa6\x77\x69\x4a\xd6\x2d\x35\xb3\xda\x0f\x97\x3a\x8b\xe7\x6a\xb4\xef\x8a\xd9\x90\x0c\x54\xd0\xb9\x6e\x6a\x5c\xaa\x87\x62\x8d\x72\x00\x59\x5f\x5e\xb5\xeb\x31\x28\x37\xa2\x6a\x0e\x72\x8a\x60\x3a\x9f\x6e\x79\x7f\x36\x9b\xb5\x7f\xff\xd2\x81\xec\x95\x2b\x37\x5c\xbd\xba\xe9\x3d\x36\x2f\x2c\xc2\x5e\xdd\x20\xfc\xa6\xff\xc2\x2e\xe5\x95\x59\x7a\xbf\x84\xc0\x7f\x5f\xed\xff\xd5\x9d\xd6\x06\x3f\x79\x71\x07\x4e\xcc\xd4\x91\xa6\x95\xcb\x1a\x72\xc4\xa9\x10\x76\xf7\x72\x4d\x5b\xfb\xc6\xe5\xed\x55\xc8\xc3\xd3\x3e\x4e\x6a\xb8\xd1\xcc\xf8\x7d\xb3\x06\x23\xb2\xc8\x27\xda\xe1\x45\x17\x48\xc2\xd2\x0c\xb6\x62\x73\x5b\xb5\xac\xc3\x8a\x9f\xb6\xd7\x11\x87\x19\x71\x9d\x0f\x31\xc2\xbe\xf5\x91\xaf\x97\x7d\xcb\xe0\xed\x5e\xfe\x82\x15\x85\xe9\x12\xae\x86\xf8\x67\xb7\xf1\x08\x0b\x49\x50\x69\x5e\x47\x87\xed\xc9\x57\xa1\xd0\x4c\x95\xc5\xb2\xfe\x9e\xaa\x2e\x66\xd3\x5e\x87\x99\x1d\x7c\x56\x07\x25\xba\x69\xa5\x6f\xd0\xcc\x40\xd4\x7f\xd5\x66\xf5\xbd\x31\x53\xb1\x75\x66\xb3\xd2\xea\x41\xfa\x23\xb7\xff\x30\xd3\x5f\x26\x68\xd6\xdd\x47\x5b\x96\x19\xc8\xce\x78\xcc\xe0\x4e\xb6\x5f\x8d\x6f\xb5\x2e\x7e\xed\x0d\x53\xb3\x7c\xb7\xbb\x50\x9a\xbb\x0d\x75\x7c\x3f\xd9\x9e\xfb\xbb\xc9\x10\xec\xd5\x0d\x7a\x65\xb1\xf9\x6a\x67\x47\x19\x2c\xda\x0d\xb5\xf3\x5c\x17\xaf\x76\x44\xfb\xf1\x5d\xd6\xec\xad\xa2\xb3\x0e\x33\x7e\x4d\x64\x0f\x6f\xbf\xe9\x67\x46\xee\xac\xc8\x6d\xa4\x4a\xb3\x5c\x3a\xbb\xd2\x1e\x2c\xae\xb3\xcc\x8d\x32\xc0\x01\xd6\xdf\xf9\xb1\x2e\xc9\xf1\x0c\xc7\x25\x47\x6b\x13\xb9\xd2\x41\x47\x87\x75\x85\x7e\x4e\x6b\x46\x4e\x6b\xe6\x9f\xd6\x2c\x38\xd2\xec\x00\x2b\xb6\x65\x4e\xb6\x1c\x58\xac\xb5\x43\xc2\x14\x7d\x9f\x65\xae\x78\xbb\x2b\xd5\xf8\xb7\x22\xcd\x9b\x1b\xa4\x33\x96\xcb\x19\x32\x04\x60\xba\x28\xa7\x0d\x51\x5d\xa9\x77\xd3\x38\x9d\xe7\x45\x79\x86\x7a\xa8\x49\x60\x58\x77\xfc\x5e\x63\x10\x46\xef\xa3\x30\x26\x51\x1c\x27\x3d\xfe\x7e\xe5\x88\xe4\x46\x90\x52\x91\x90\x30\xe9\x71\x20\x82\x26\x3c\x4a\x04\xe1\x38\xa2\x4a\xf8\x31\x95\x8c\x25\x21\xe1\x2c\x56\x5e\xe4\x8b\x80\x79\x5e\x44\xa8\x0a\x43\x16\x48\x15\x12\x9f\xfb\xa0\x5e\x1d\xe1\x7e\xa7\xdb\xab\xda\xc1\xaf\xf9\xc5\xd5\x61\xc5\x1b\x08\x13\x19\xc4\x21\xe3\x10\x25\xa1\x88\x55\x14\x33\xca\x88\x4f\x3c\xe5\xfb\x8c\x86\x11\xc7\x3c\x10\xb1\x27\x9d\x3c\x75\xf8\x74\xc0\xcf\x10\xfc\x7d\xcd\xb2\xca\x8c\xf2\xd4\x25\xb4\xa2\x74\xcf\x88\x6e\x76\xc9\x59\xa8\xde\xdd\x0b\xd6\x96\x7c\x22\x88\x93\xdd\x9d\x33\x76\xa9\xf5\x71\xe6\xfd\x56\x7e\x38\x85\x3c\x7e\xa6\xdd\x51\xd6\xe8\x88\xf1\xd9\xd1\xef\x9d\x8c\xab\xbe\xe5\x79\xda\x18\xb5\xb9\x3a\xd9\xdb\x95\x3f\x0f\x59\xa8\x97\x08\x1d\x35\xa2\xb4\x9b\x2a\xb6\x73\xc8\x38\x66\xe1\x9a\xb6\x46\x56\xd6\xe5\x4c\x5a\x35\x6e\xb5\xc1\x8c\x55\x62\xf6\x38\x83\x86\x55\x62\xe7\x89\x81\x62\x9f\x9c\x4d\x54\xea\x14\x8d\x70\xc6\x4d\xa4\x6e\x08\xf0\xd4\x2d\xdc\xc9\x5a\x3d\xf5\x9c\xf6\x69\xd3\x9c\x73\xec\xfa\xb8\x03\xfc\x1e\x8a\xbf\x6d\x9a\x6e\x18\xf4\xeb\xdb\x37\xf6\x7f\x6d\xe9\xd7\x31\x3a\xda\x1a\x5b\xe7\xf0\x94\x5e\x14\xe5\xf5\x9d\x37\xc5\x53\xfc\x36\x8a\x28\xe6\x09\x7d\x2b\xe1\xee\x3a\x4b\xf3\xf5\xe6\x7a\x5e\x78\x53\x0f\x4f\xfd\xce\xf5\x4e\xa8\xf4\x0f\x27\x5f\x4a\xdd\x2d\x73\x40\x63\xee\xb3\x40\x06\x42\x2a\x4f\x88\x90\xc8\x30\xe2\x49\x8c\x03\x15\x08\x8f\x2a\x4c\x30\x78\x3c\xa0\x92\x73\x15\x30\xe2\x4b\x0f\x20\x50\x9e\x62\xa1\x52\x49\xd0\x55\x33\xe7\x5c\x02\x69\x61\x88\x68\x90\xc4\x5b\xb7\x10\xa0\x3c\x73\x0d\x21\x06\x8f\x10\x16\xe2\x10\x20\x0c\x39\x0d\x7c\xdf\xc3\x11\x65\x42\x49\x1a\xc6\xe0\xc7\x4c\x86\x54\x05\x91\xcf\xb0\x62\x3c\x61\x4c\x29\x22\x3c\x08\x38\x01\x22\x09\x61\x10\x7b\x52\x78\x81\x92\x4c\x45\x00\x4c\xc6\x01\x97\xbe\x8a\x70\x98\x04\x51\x10\x30\xe6\x87\x22\xa4\x54\x25\x82\x45\x1c\x7c\x3f\xf0\x80\x08\xf0\xa8\x94\x22\xf0\x7c\x9f\x74\x2e\x0d\xe4\x60\x4f\x66\xcf\x82\xde\x23\x74\xea\x4d\xfd\x64\xea\x11\x7c\xe3\x79\xc4\xef\x9c\x70\xa4\x39\x2f\xd6\xf9\x53\x42\xf0\x72\x7d\x7a\x24\x73\x7b\x10\x40\x6b\x39\xf5\x1f\x5b\x4a\x3c\x2a\x65\x7b\xcf\x38\xba\xe8\xc7\x13\xb7\xff\x6b\x6a\x4f\x8d\x06\xa5\x76\xda\x8c\x21\x73\x44\xce\xa4\xb9\x4c\x85\xbd\x75\xda\xad\xba\x52\xd7\x36\x73\xa5\xca\xec\x57\x52\x17\x69\xe5\xce\x40\x39\x08\x9b\x97\x5b\xb2\x5c\x2c\xba\x5f\x35\xe9\x56\x84\xba\x84\xec\x18\x90\x5d\x01\x7f\xd0\xb0\xf3\x8c\xa7\xf3\x92\x2d\x77\x1e\xf6\xce\x66\xdd\x23\xb8\x5b\xca\xb4\xda\x79\x98\x17\xc5\x6a\xe7\x51\xb1\xb2\x69\x2d\x3b\x4f\x57\x25\xec\xde\xd3\xb1\x11\x94\x72\x68\xf6\x75\xbe\xfb\x74\x84\x00\x06\x1d\xf5\xed\x19\x01\xe5\x14\xbd\x5f\xae\xf4\x83\x7b\xda\xf1\x7a\x9b\xd8\x47\xa5\xcb\xb5\xb0\x1f\x75\x98\xbb\x62\xfa\xa6\xcf\x10\xcf\xbf\xea\xd8\xe0\xac\x9c\x0f\xe9\xb9\x71\x37\xb7\x0f\x65\x1d\xde\x51\x29\x48\xb4\x62\xda\xdd\xf7\xb1\xe3\x6e\x4f\xdb\xc5\xf6\x3b\xb9\xee\xf7\xa3\x4b\x58\xcd\x1e\xde\xa0\x22\xcf\x1e\x3a\xe9\x15\xed\xbd\xac\x29\xfa\x83\x8b\x93\xf4\x3a\xce\xea\x7a\x04\xd7\xaf\xf5\xc6\xde\xbd\xfe\x87\xde\xdc\xca\xef\xae\x3b\xb7\xb1\x67\x87\xc5\xbf\x64\x9c\x07\x32\x52\x98\x19\xdb\x25\x66\x32\x16\x12\x03\x8e\x99\xa7\x08\xe6\x61\x10\x49\x8e\x63\x1f\x4b\x1a\x25\x32\x14\x82\x63\x29\x09\xf3\x22\x88\xc3\x24\xe4\xd7\xf8\x1a\xf7\xeb\xf0\x74\xca\x5e\x3d\x43\x34\xa1\x8f\xe6\xfd\xf4\xaa\x03\xcb\x64\x41\x44\x62\xec\x47\x40\x70\x12\x02\x8f\x3d\x41\xfc\xc0\xc3\x61\x20\x19\x8b\xfc\x30\x8e\x05\x8e\x48\xd0\x2d\xc6\xf4\x05\x1e\x7e\xd6\xac\x3c\xd7\x14\x3c\x9e\x3d\x3b\xfe\x9b\x74\xbc\xe0\x4d\x3f\x9c\xbf\x85\xc0\xc5\xff\x86\x20\xe8\x44\xb2\x4f\x66\xe3\x1d\xf0\x41\x82\xe2\x41\x40\x23\x1a\xaa\x44\xc4\x44\x09\xc2\x93\x20\x4a\x28\x06\x15\x7a\x92\x4a\x82\x29\xe7\x8c\x05\xd2\x57\x52\x28\x2c\xc2\x58\x06\x34\x88\x99\x60\x04\x0e\xb0\xc3\xa8\x7c\x83\x8d\xfe\x13\x9c\x72\x31\x77\xf0\xeb\x4f\xfd\x7b\x27\xfd\x52\x50\xdb\xb1\xea\x92\x76\x47\xc6\x9a\xe0\x8d\xef\x43\x40\xfc\x84\x62\x91\x70\x3f\x96\x38\xa0\x5c\x1a\xbd\xc3\x65\xc0\x08\x03\x9e\x84\x5e\x10\x25\x84\xe0\x20\x0c\x70\xc8\x84\x10\x44\x05\x11\x95\x18\x54\x12\x25\x94\x4e\x76\x93\x7e\xbe\xf4\x97\xd6\x4e\xf4\x44\x3e\xd9\xfb\x6e\xcf\x5e\x26\xd0\x85\x66\x12\xf5\x9e\xf8\x01\xd8\x68\xc6\xc8\xb7\x02\x0a\x17\x28\xa0\xf0\xad\x66\xc1\x41\x2a\x3c\xb2\xaa\xcb\xcb\xba\x24\x6d\x2b\xd5\x9e\x41\xdc\x05\x6c\x4e\xb7\x37\xba\x65\x70\x4f\x28\x80\xfb\x4c\x0a\xec\xdb\xef\xeb\xfe\x75\x2c\xa0\xcb\x6d\x99\x7d\x66\xad\x05\x7b\xa1\xdc\x75\x78\xb5\xce\xeb\x2a\x0a\xc6\x7a\xef\x72\xf2\xa0\xc8\xdf\x3e\x73\xb6\x46\xfd\x75\xcf\x51\x67\xb5\xdf\x04\x9d\xea\xfe\xed\xbb\x79\x69\x85\xec\xbd\x53\xa4\xcd\x80\x06\x80\xdd\x1a\xd2\x75\x7a\xb6\xfb\xcc\x71\x33\xe3\xf6\x23\x20\xdb\xc2\xb5\xa9\x95\xd0\xed\x37\x80\x8f\x5c\x1d\xa8\x5b\x0d\x16\xb6\xdd\x2d\xf3\x3a\x28\x58\x86\xef\xf4\x3f\xee\x32\x88\xed\xfc\x89\xdd\xd7\x25\xb0\xfb\xab\x2c\xd9\x7d\x67\x85\xdd\x1a\xf2\x83\xb8\x2d\x9b\xda\xc0\xcc\xf4\xec\x66\xdd\x4f\xf7\xd6\xdc\x8d\x6f\x0c\x2f\xba\x21\xa8\x83\xb0\xfd\xe4\xf4\x10\x98\xf5\xcb\x53\x60\xad\x6f\x0b\xf6\xcc\x92\xa2\x44\xb7\xef\xa6\x36\xf8\xb6\xe5\x0d\x56\xb9\x1b\x93\xa9\x42\x85\x3b\xb9\x9a\x9e\x42\xa3\x1d\x68\xf7\x39\x67\x00\xd8\x43\xac\xf3\x8f\xae\xc2\xb1\x7a\x7f\xf8\x5b\xd6\x1d\x87\xb9\xfb\x49\xeb\x27\xf2\xd9\x36\x2d\x02\x2a\xed\xd6\xd5\xf9\xe4\x7f\x7f\x51\x0b\x60\xf2\x14\xec\xbb\xeb\x9e\xa6\x75\xf3\xd5\xed\x63\x48\x3f\x19\xe7\xb5\x9f\xf2\x27\x78\xe8\x63\x7d\x0c\xc1\x46\x18\x7c\x81\x87\xd7\xab\xba\x0e\xfc\x77\xc6\xab\x67\x42\x98\xfd\xda\xdc\xf3\xa9\x7d\x91\x31\x64\x3a\x1c\x7c\x81\x87\x47\x20\xf7\x72\x15\x6a\x5d\xcc\xbf\x95\x59\x03\x54\xda\x17\x5a\x07\x09\x35\x78\xe1\x29\x15\x0b\xfb\x29\xf4\xe6\x46\x64\xb5\x93\x03\x70\xce\xee\x7e\x14\x36\x82\x30\x82\xe6\xb0\xb5\xb7\xea\x0f\xa5\x84\x72\x70\xcd\xf6\x40\xe1\x94\x15\x77\xca\x95\x9f\x7c\x06\xf1\xe8\x05\xef\xc7\xf9\x76\x4f\x28\x7a\xe7\x7a\x2d\x7e\x4c\x1b\xfb\xec\xf3\xe6\xf6\xdd\xe9\x7c\x5e\xdf\xb2\xde\xbb\x47\x3c\xc2\xcd\xe9\x49\x3b\x6f\x9f\x7c\x09\x17\x22\x0a\x49\xc4\xe2\x88\x41\x18\x61\x12\x04\xca\x38\xd4\x38\x14\x02\x63\x2f\x89\x63\x12\x44\x82\x27\x44\x10\x1e\x28\x0f\x08\x8f\x19\xc1\x01\x04\xc6\x11\x4f\x80\xf5\xbe\x5b\xb7\xf3\x7d\x86\x3e\x65\x57\xc5\x49\x9c\xbc\xa5\x2b\x43\x15\xbb\x6b\x8b\xd0\xdd\xbe\xb3\x02\xb3\x84\x6a\xbd\x74\xa1\x5e\x40\xdd\x2f\x68\xf4\x44\xd3\xed\xbb\xa7\xaa\x84\xf7\xf5\x07\xb1\x07\x97\xd2\x7c\x2d\xfb\xc0\x7a\x86\xd9\xec\xe0\xe7\x36\xb6\x86\x4e\x09\x7a\x5d\xe6\xed\x92\xd3\xaa\x9d\x69\x1c\xf0\x9e\xea\xfd\x08\xf6\x96\xd8\x30\x0d\xdc\xbb\x8b\xc2\x5d\xd4\x60\x23\xbd\x79\x63\xe5\x0c\x4a\xf5\xa4\xda\x99\x6a\x1c\xee\xff\x09\x00\x00\xff\xff\xbb\xbb\x78\x21\xe2\xa0\x00\x00")
+var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x77\xdb\x38\x92\xe8\x77\xfd\x0a\x9c\x9e\x7b\xaf\x92\x3e\xb2\xcc\x87\x44\x52\xfe\x96\x4e\xb2\x3d\xde\xed\x9d\xf8\x3a\xde\xe9\x7b\xce\x9c\x39\x23\x90\x28\x4a\x9c\x50\x84\x96\x80\x6c\x79\x66\xf7\xbf\xdf\x53\x78\xf0\x21\x51\xb2\x24\xcb\x69\xa7\x37\x76\x3e\x38\x24\x01\x14\x80\x42\xbd\x51\xc5\x97\x50\xd0\x65\x76\x45\xfc\xa1\x33\x74\x7b\x59\x91\xf2\xab\x1e\x21\x32\x93\x39\x5c\x91\xbb\x39\x2f\x41\xc8\x1e\x21\x0c\x44\x52\x66\x4b\x99\xf1\xe2\x8a\xfc\x57\x8f\x10\x42\x6e\x3f\x7e\xbe\x4b\x57\x39\x79\x77\x73\x4d\x24\x27\x34\x49\x40\x08\xf2\x67\x78\x3f\xa7\x59\xa1\x9a\x92\x3f\x81\x7c\xe0\xe5\x97\x9e\xfa\xfe\x2f\x37\x25\xff\x3b\x24\x92\xfc\x91\x2f\xe0\xaf\x6f\xe6\x52\x2e\xc5\xd5\xe5\xe5\x2c\x93\xf3\x55\x3c\x4c\xf8\xe2\xf2\x1e\x12\x6c\x7b\x29\xe7\xbc\x7c\xdb\x23\x24\xcf\x12\x28\x04\x20\x40\x84\x14\x74\x01\x57\xe4\x97\x9f\x6f\x7e\x41\x58\xd5\xa3\x55\x99\x5f\x91\xbe\xed\xe8\xe1\xe1\x61\x38\x2b\x56\x43\x5e\xce\x2e\x4d\x4b\x71\x99\xcf\x96\xf9\x05\xce\x0d\x8a\xe1\x5c\x2e\xf2\x7e\x8f\x90\x7b\x28\x85\x9a\x87\x3b\xf4\x87\x5e\xaf\x27\xa0\xc4\x47\x38\xcc\x85\xe9\xf3\x12\xbf\xdb\x98\x75\xce\x13\x9a\x13\x84\x8d\x14\x9c\x41\xaf\x27\xe9\xcc\x34\xd2\xb0\xbd\x4b\x12\xbe\x2a\xa4\xd8\x6e\xfa\x4e\xaf\x8d\x5e\x25\xfc\x86\xf0\x18\x97\x42\x34\x5a\xdf\x95\xb4\x10\x34\xc1\x06\x7b\x7b\x90\xed\xef\x6c\xf3\x9f\x72\x9e\x7c\xd9\xdb\x30\xb6\x5f\xd8\x26\xbf\xf0\xd9\xde\x06\x70\x0f\x85\x24\xff\x47\x8f\x98\x42\x49\x72\x3e\x6b\xb6\xff\x13\xae\xc2\x9e\xf6\xb8\x4a\x44\x48\x2a\x57\x82\x20\x62\x35\x9a\xde\xad\x6f\x38\xcf\xb7\x1b\x5f\x17\x62\x89\x28\xb2\x84\x82\x65\xc5\x6c\xd7\x64\x3f\xaf\xe2\xaa\x51\xc7\x14\xcc\xeb\x18\x48\x56\x48\x40\x0c\x06\x46\xc4\x6a\x6b\xc9\x3f\x40\xbc\x9a\x6d\x37\x57\x8f\xc9\x4a\x66\x79\x26\x33\x68\x36\xb8\xbd\x79\xbf\xfd\xf9\x47\x39\x87\x12\x56\x0b\x92\xf0\xc5\x92\xca\x2c\xce\x81\xfc\xeb\xe7\x4f\x7f\xba\xb0\x5f\xf7\x96\x54\xce\x15\xa6\x5c\x9a\xed\x17\x97\xff\xa4\x8c\x95\x20\xc4\x7f\xe3\x63\x42\x96\xb4\xa4\x0b\x90\x06\x0b\xf1\xc9\x05\xf9\x5f\x25\xa4\x57\xa4\xff\x87\x4b\xec\x97\x17\x50\x48\x71\x59\x7f\x77\xf9\x4e\x77\x70\x5d\xdc\x50\x39\xef\x1f\xda\xea\x16\xee\x33\x44\xfe\xeb\xe2\xff\xae\xa0\x7c\xd4\xed\x66\x20\xed\xb0\x16\xa7\x6d\x77\x2d\x9c\x26\x44\xac\x16\x0b\x5a\x3e\x5e\x91\x5b\x90\x65\x06\xf7\x50\x21\x34\x03\x49\xb3\xdc\x7c\xd6\x5a\x9f\xff\x32\x0f\x09\xc9\x8a\x24\x5f\x31\x10\x64\x1a\xd3\x9c\x16\x09\x4c\x07\x64\x0a\x05\x94\xb3\xc7\x29\xa1\x05\x23\xd3\x39\x15\xef\x39\xc3\xe7\xf1\x63\xd5\xf5\xd4\xac\xd5\x74\x48\xde\x15\xd5\xd3\x87\x4c\xce\xeb\x06\x24\x06\xf2\xa3\x2c\x57\xf0\x23\xc9\x04\xa1\x24\xe1\x85\x2c\x69\x22\x87\xbd\x6a\xf4\x3f\x66\x42\xf2\x32\xc3\x43\x6c\xfb\xd0\x40\x93\x84\x16\xd8\xfe\x3f\x57\x50\x66\xc0\x48\xfc\x48\x10\x0b\xb3\xf4\x11\x51\x70\x5a\x9a\x25\x9b\xaa\x0f\x1e\x89\x90\x65\x56\xcc\x86\xa6\xdf\x12\xc4\x92\x23\xa9\xa9\x57\xad\xef\x39\x4e\xbf\xfe\xef\xc6\x72\x7c\xfa\xb7\xc6\x1b\x04\x13\x8a\x6a\xf5\xf5\x3f\xba\x5c\xe6\x59\x42\x11\xbb\x2e\xff\x2e\x78\xd1\x7e\x4b\x88\x48\xe6\xb0\xa0\x9b\x4f\x49\xe7\xd6\xeb\x6f\xc5\xa5\xd9\xc7\xbe\x5e\x8e\x25\x17\xd5\x98\x0c\x96\x25\x24\x54\x02\xbb\x22\xb8\x80\x47\x22\xc2\xc7\x35\x24\x2b\x59\xe3\x41\x62\x89\xc2\x4e\x2c\x90\x9c\x88\x6c\xb1\xca\xa9\x84\x6a\x9b\xc8\x02\xe4\x9c\x33\x92\xd0\x3c\x1f\xa8\xad\xe5\x2b\x49\xc4\x36\x15\xa8\x08\x19\x51\xac\xc2\xee\x02\x21\xd5\x1f\xd7\xb2\x2f\xc8\x4a\x00\xb2\x26\x24\x62\x42\x66\x0b\x1c\x6a\x46\xf1\x31\x9d\x81\xc2\x34\x50\x60\x67\xbc\x20\x25\x88\x55\x2e\x09\x4f\x11\x6b\x72\xba\x12\x50\x6f\xed\x7f\xae\x40\xc8\x9f\x38\x7b\xbc\xea\x75\xee\x25\x2d\x67\xab\x05\xae\xb3\xee\xb3\xb8\xcf\x4a\x5e\xe0\x83\xea\x73\xec\x23\x2b\x37\xd6\xb6\x73\xdf\xf7\xef\x7a\xf7\x9e\xef\xdb\xf1\xf7\x34\xcf\x3f\x50\x49\xfb\xdf\x16\xa2\x22\xd8\xb7\x6a\x4b\xfa\x2d\x82\xf9\xe3\xd5\x16\xe6\xd6\x64\xad\x1e\xe2\x34\x02\x78\x02\xba\x93\x98\xca\x64\x8e\x68\x83\x18\x2f\x7a\x1d\x0b\xd8\x8d\xf2\x35\xe6\x29\x94\x6b\xe0\xf6\xef\x03\xef\x7e\xc2\x75\xf9\x46\x91\xaf\x82\xdd\x62\x60\x13\x05\xaf\x0e\x25\x9d\xbf\x25\x5e\xc6\x8f\x12\x8e\x44\xc8\x8a\x06\x33\x58\xe6\xfc\x11\xf1\xea\x6b\x50\xe0\xae\x61\x77\xd3\xe2\x46\xf7\x7f\xf8\xc3\x1f\xc8\xdd\xf5\xcd\xe7\x7a\x59\x70\x61\xa6\x8c\x4a\x3a\x25\x59\x61\x8f\x0f\x89\x39\x7b\x44\x61\x40\xce\x1b\xcb\x62\xfa\x36\x63\xef\xec\x41\x63\x6b\xab\x8b\x72\x55\xc8\x6c\xd1\xec\x8a\x0a\x91\xcd\x0a\x60\x4d\xb9\xfe\x61\x9e\x25\x73\xf5\x7d\x35\x3f\xe4\x58\x60\x66\x09\xec\x77\x71\xc6\x7f\x07\xbc\xa5\x5b\x1a\xbf\xc4\x9d\xbd\xea\x75\x9f\xe2\x6f\x4d\x24\x7f\x5a\x14\xcb\x52\x42\x8b\xc7\x21\xf9\x23\x94\x60\x90\x96\x01\x9e\x99\x2d\x64\x1f\x7e\x63\x3b\xcd\x19\xec\xdc\x63\x54\x03\xe8\x0c\x2e\xff\xf9\x05\x1e\xbf\xb6\xfe\xf5\x59\x8f\xfd\x6f\xf0\xf8\x5a\xb0\xc4\xac\x06\xb9\xa7\xf9\xea\x09\x74\x49\x79\x49\x66\xd9\x3d\x14\xe4\x0b\x3c\x7e\x63\x18\x61\x16\x7e\x27\x52\x2c\x4b\xce\xd3\xd7\x70\xf2\x6b\x6b\xc3\x17\x78\xb4\xdb\x87\xba\xf3\x95\xd6\x3f\x7b\x9d\x8b\x5a\x6f\x12\xae\xe9\x62\x41\x89\x00\x1c\x49\x02\xab\x76\x18\xfb\x43\x5e\x15\x03\x59\x96\xfc\x1e\xd8\x80\xac\x96\xf8\xc0\x75\x9c\xf6\x60\xdb\x0b\x2c\x1f\x97\x70\x65\x54\xdf\x67\xa3\xde\x02\xca\x2f\xb9\x02\x82\xa7\x9a\x23\x1b\x5c\xa4\x45\x05\x6d\x6f\xef\x24\xe9\x8c\x66\x85\x90\x8a\x66\xa1\x85\x09\x48\xc9\xb9\x52\xe2\xf0\x89\xc6\x51\x25\xa4\x58\x2c\x6d\xc8\x0f\x1f\x69\x32\xd7\x63\x23\xa5\xa3\x24\xcf\x84\x6a\x79\xfb\xcb\x0d\x81\x02\xa9\x1d\x23\x08\xa8\xb2\xf2\x89\x01\x49\x4b\xbe\x50\x03\xa9\x21\xf0\x21\xae\x19\x3e\xc8\x81\xa6\x43\xf2\x6f\xb8\xac\x66\x64\x83\x58\xaa\x7d\x35\x60\x63\x56\xea\x85\x20\xb4\x04\x12\xe7\xf4\x0b\x78\x31\x99\x53\x31\x07\x36\x24\x77\xa6\x43\x7d\x10\x9b\xab\x82\x6d\xac\x14\xd2\x04\xd2\xbc\xaf\xc6\x99\xfe\xc5\x98\x55\x06\x44\x1b\x55\x06\x7a\x0d\xee\xb2\x05\x0c\xc8\x82\x0a\x09\xe5\x40\x91\xf8\x3f\x52\x31\x1f\x58\x98\x6e\x39\x97\x7f\x9d\x0e\x94\x98\x21\xb7\x80\x68\x02\xde\x00\xa2\x1a\xd4\x02\xa3\xa1\x46\xb9\x11\x67\xa1\x84\xc6\x7f\x40\xc9\x05\xce\x78\xb1\xc0\x09\xde\xa8\x25\xc7\x79\xc5\x02\x8a\x44\xf3\x19\x90\xab\x12\x45\xa8\xac\x9e\x2e\x2f\xab\x41\x45\xce\x25\x61\x1c\x04\x29\xb8\x24\xb0\xce\x84\xfc\xc6\xc8\x8e\x39\x0b\x6a\xee\x9a\xf6\x34\x14\x3e\x71\xf9\xcf\x8c\x9d\xce\x81\xee\xd6\xd7\x1f\x8e\xa5\x38\xf4\x61\x8b\xd8\x3c\xd1\xe4\x8f\x40\xd9\xb1\x6d\x6e\xb4\xda\x70\x28\xaf\xda\x32\x7d\x77\x11\x8d\xc6\xba\xf5\x3a\xb6\xb7\xa6\x0d\xf1\x23\xb9\xfe\x30\x24\xbf\xce\xa1\x20\x53\x63\x48\x9e\x22\xb2\xa1\x8a\x36\x20\xb4\x36\x2e\xaf\x95\x9e\x43\x8a\x55\x9e\x93\xe9\x02\x50\xfa\x5f\x64\xb3\xb9\x44\x79\xdd\x62\xe6\x2b\xc4\x37\x5e\xc0\x27\xc3\xaa\xda\xbf\x17\x84\xe6\x79\xf7\xab\x5d\x9b\x66\xf1\xf4\x6e\xdd\xef\x75\x34\x42\x3a\xb9\x84\x12\xcd\xe0\xdd\xbd\x12\xb4\xdc\x75\xc0\xb8\xad\xa3\xa4\x34\x17\xd0\xeb\xf8\xe4\xc9\x33\x74\xb7\xfe\x77\xa8\x75\x8d\x33\x4d\xf8\x96\x3e\x7c\x9b\x73\xde\x40\xb3\x92\x3e\x74\x1c\x8d\xfa\x17\xd6\x74\xb1\xcc\x8d\x4e\xd3\xfe\xcd\xd8\x15\xe9\x3b\xeb\x11\x83\xd0\x4d\x3d\x36\x8e\x22\x4a\x23\xea\x02\x75\x9c\x14\x22\xdf\xf5\xd8\xc4\x9b\x04\x01\xa3\x23\x6f\xc4\x26\x13\x7f\x42\xc7\xae\x9b\x26\x4e\x0c\x91\x0b\xc1\x38\xa5\x6c\xec\xd1\x34\xea\x02\x52\x99\x06\xee\xe8\xec\x8a\xb8\x1d\x6f\x15\x57\xba\x55\x93\x77\xd6\x8e\xfe\x71\x6d\xdf\x5d\xdd\xc1\x7a\x99\x95\xca\x44\x75\x45\x7c\xa7\xe3\x03\x6d\x2c\x10\x57\xe4\x2f\x7f\xed\x78\x3b\xa3\xe2\xa6\xcc\x12\x78\xcf\x71\x4c\xd7\x8b\xba\xbf\xb9\x22\x9e\xeb\x38\x5d\xdd\xf3\x32\x9b\xa1\x00\xd6\x77\xd6\xe1\x38\x08\x59\xe4\xc7\x61\x1c\xb1\xc8\xa1\x8c\x25\xb1\x17\xb9\x34\x74\xd9\x78\x94\x26\x61\xec\xfb\xc1\x28\x4d\x81\x75\x4d\x83\x41\x0e\x33\x2a\x79\x79\xa5\x68\x4e\xc7\x17\x05\x2f\x12\x50\xe3\x6c\xae\x7d\x77\x7f\x48\xca\xc4\xa7\x62\x67\x7f\x22\xfb\x07\x5c\x11\x37\x72\x7a\xc7\x20\xb1\xda\x9f\xeb\x0f\xad\xed\x49\x46\xe3\x68\x32\x9a\x4c\xa2\x31\x0d\x58\x14\xc4\xa1\xeb\x4f\x82\x89\x13\x47\x91\xeb\x32\xe6\xc7\xa3\x60\x14\x26\x8e\xc7\x46\xe9\xc8\x4d\x18\xa4\x71\xc8\x7c\xcf\xf7\xc2\xfe\xee\x11\xfe\xb4\x5a\xc4\x50\x76\xa3\x88\xf9\x04\x45\x17\x21\xe9\x62\x79\x45\xdc\xb1\xe7\xbb\xe3\xc0\x0b\xdd\x6e\x36\x7a\x59\x42\x02\xd9\xd2\xd0\xd8\x9a\x19\x5d\xf5\xf6\x91\x83\xe7\xb1\xd3\x53\x78\xe3\xaf\x99\x9c\xdf\xc2\x3d\x94\xf2\x16\xa8\xe0\xc5\x4b\x31\x49\x62\xd6\xa3\xd7\x41\x34\x36\x99\xe5\xeb\xe3\x71\x3b\xe9\xfa\xc5\x5e\xb2\x79\xab\xe7\xdc\xef\xb5\xda\xb4\x69\xba\x7d\xd4\x52\x0a\x0e\x39\x16\x07\x0c\xac\x89\xf6\x26\x7e\x6e\x5b\x8e\x8f\xd9\xdc\xf7\x7c\xb1\xc8\x64\x07\x91\xdf\xb1\xa5\x68\xc0\xa4\x0f\xc3\x7d\x86\xc6\xdf\xce\x72\xd8\x62\xbb\xaf\x08\xdf\xf6\xc1\x7c\xf7\xff\xae\x3f\x74\xc8\xee\xd6\x80\x7e\x32\xc1\xe9\x54\xff\x4f\xc5\x92\xcf\xd6\x9c\x7f\x30\x9e\x50\x41\xb2\x94\x64\xe8\x2e\x5d\xd2\xe4\x0b\x2a\x61\x05\x5a\xb2\x49\x01\x0f\xc6\xc2\xaf\xac\xfd\xcb\xb6\x5a\x6d\xdd\xe1\xb5\x9b\x16\xed\x0d\x99\x94\xa8\xf2\xd1\xe2\x51\xce\x1b\xde\xf1\xc6\x09\xbb\x9b\xb7\x60\xb3\x4e\x77\xdd\xa9\xc6\xd9\x01\xe1\x25\xa1\x02\x05\x73\x65\x79\x4f\x33\xc8\x99\x18\x92\xff\x28\xac\xa1\xbd\xd1\x1e\x75\xf7\x24\x81\x25\x5a\x38\x10\x92\x6a\x20\x58\x23\xca\x66\x92\x4c\x35\xdb\x36\xaa\xed\xb4\xe2\xbe\x53\x9c\xb7\xf9\x9f\xd5\xbc\x05\x5d\x00\x49\xe6\x90\x7c\x41\xbb\xbe\x5a\x10\x35\x1f\xb3\x10\xa8\xb0\x2f\xa1\x4c\x79\xb9\x00\x36\xa8\x86\x12\xab\x64\x8e\x9f\x2b\x71\x07\x4d\x70\x46\xe3\x26\x25\xa4\x83\x86\xd4\x32\x30\xac\x1a\x8a\xe4\x71\x80\xcb\x5c\x66\x85\xc8\x12\x14\x3a\x8c\x75\x1f\xd5\xf5\x21\xb9\x56\xf6\x58\x0d\x07\x49\x69\x96\x8b\x7a\xac\x69\x09\x18\xbf\x02\xac\xd2\x65\x08\xcd\x79\x31\x53\xdb\xa0\x8c\x0f\xa5\xe2\x27\x43\xf2\x09\x03\x52\x1e\x32\xa1\x4d\xba\x0f\x7c\x95\xb3\x0b\xa5\xd1\x28\x12\xa5\x06\x5c\x42\x69\x1c\x2c\xc6\xe7\xa2\x6d\x12\xdb\x4a\xcf\xab\x22\x1e\x16\xc7\xef\xd6\xdf\xa0\xf3\xc1\x02\xdf\x74\x40\x34\xf0\x59\x5c\x5a\x3f\xd9\xeb\xa0\x27\x1f\x9b\x5e\x3b\x44\x99\x14\xa0\xd7\xb1\x98\x35\x3d\x41\xeb\x30\xad\x94\xea\x9a\x60\x18\xd9\x7c\xf0\x5c\x82\xd3\x08\xe5\xc1\x13\xbb\xc8\x8a\x6c\x41\x73\x75\x86\x32\x41\xe2\xac\xa0\xe5\x23\x11\x40\xcb\x64\xae\x83\x78\x8c\xa7\x1d\x35\xfd\x39\xd4\x60\xe8\x28\x24\x3c\xdd\xad\x83\xa8\x4e\x9f\xf9\x48\x9d\xbd\x6a\x34\x8c\x83\xab\x27\xa5\x01\xc5\x51\xf3\x6c\x91\x49\x45\xb3\xf0\x39\x86\xae\xd4\x8f\xed\x14\x94\xb9\x30\x4b\x49\xce\x1f\xd0\xf8\x86\xc1\x44\x50\xee\x38\xc4\xd5\x80\xd8\xf0\x7e\x41\xa0\x2c\x79\x59\x53\x52\xa5\xc2\xe8\x73\x9a\xd0\x3c\x51\xd4\x9e\xd5\xd6\xc9\x64\x55\x96\xe8\x43\x8d\xa9\xd0\x9b\xb6\xc4\xef\x07\x8d\x85\x9c\x36\xf5\x20\x13\x70\xa5\x0d\xc1\xbf\xf2\xf2\x4b\x6d\x01\xac\x46\x4c\x41\x19\xe9\xb0\xdd\x7f\x08\x60\xe4\x47\x62\x7b\x98\x0e\xc9\x54\xac\x66\x33\x15\x5a\xf7\x73\xab\xdb\x4c\x10\x06\x65\x76\xdf\x84\x2d\x5d\xe5\x79\x81\x51\x81\x3c\x55\x64\x08\xc1\xc4\x65\x14\x5b\x43\xea\x3d\xa3\x18\x43\x27\xd7\x18\x36\x88\x08\xb5\xe4\x3c\x7f\xa5\x24\xc9\x1e\x93\x6f\x90\x20\x59\xd0\x9b\x04\x49\x21\xb7\x38\x99\x02\x7d\x5c\x2f\x69\xc1\x80\x1d\xaa\xd3\x34\x82\x56\xbb\xb4\x19\x4a\x4a\x5a\xcc\x40\x9d\xa5\x72\x55\x7c\x21\x71\xf3\xfb\x1d\x64\x28\x2b\x08\x15\x89\x31\xf1\xf1\x92\x41\x89\xed\x0b\xa5\x6b\x0e\x48\x09\xd4\xe0\x25\x25\xa2\xa0\x4b\x31\xaf\xdd\x06\x7a\x0c\xaa\xbd\x0a\xca\xd7\xaf\xd0\x55\xe1\xdb\x90\xbc\x93\x64\xc1\x85\x54\xce\x92\x16\x1c\xa4\xc5\x3a\x11\x65\x79\x01\x64\x49\x67\x50\xdb\xd4\xaf\x3f\xd8\x41\x72\x2a\x64\xfd\xb1\xea\xc8\x9a\xd5\x93\x55\x29\x78\x49\x52\x43\x50\x0a\x58\x4b\xd3\x8d\x8e\x2a\x40\x89\x27\x17\xbc\x1a\x56\x80\xc4\xd1\xa6\xeb\x0b\xa9\xe3\xb4\x2f\xb0\xc9\xb4\xc2\x3f\x32\x07\xca\xa0\x1c\x92\x29\x5a\x07\xa6\xb6\xff\x05\xd0\xc2\x84\x34\xa8\xd5\xcd\x04\x81\xf5\x9c\xae\xf0\x28\xd7\xd4\xe6\x56\x07\x28\x20\x99\x54\xa4\x8f\xda\xe6\x05\x27\x48\xb1\xa0\xc4\xb1\xf5\x92\xbd\x61\x2b\xe5\x13\xd1\x62\x50\x09\xbc\x9c\xd1\x22\xfb\x87\x12\x7d\xde\x2a\x5a\x2a\x14\x81\xb3\xc1\xc0\x23\x67\xd2\x20\xe6\xd7\x29\x99\xbe\x53\x92\xdc\xd4\x40\xac\x14\x11\x74\xf0\x90\x69\x13\xf1\xd7\x17\x05\x43\x1d\x64\x6a\xa4\x2c\x4d\x0b\x85\x2c\x81\x2e\x80\x21\x7b\x29\xe0\x21\xcf\x0a\x0c\xb6\x50\xb4\x19\x98\x0a\xc4\xad\xb7\x41\x4f\xa1\x1a\x39\x13\x84\x17\x39\x32\x0d\xb5\x90\xf8\xc5\xe6\xda\x99\x6f\xb7\xcf\x02\xf2\xcf\x6d\x9f\x9c\x0d\x53\x47\x0c\xdb\x75\xda\x35\x2a\x5a\x7c\x48\xb3\x52\x18\x6a\x38\xa8\xe8\x18\x0a\xa8\x05\xdf\x04\x77\x9f\x65\xb1\x8b\x00\x68\x9f\x1d\x86\x40\xcf\xa0\xd9\x8b\x62\xd5\x0b\x2a\xaf\xc8\x2a\x2b\xa4\xef\x1d\x34\x23\xc9\x0f\x9b\x4f\x4e\xeb\xe9\x30\x48\x29\xc6\x56\x1a\x77\x59\x0c\xf6\xd5\xeb\x98\xd2\xd6\xf2\xb6\xa6\x65\xd0\xbd\x3e\xaa\x8f\x6a\x12\x4b\x14\x47\xf8\x4a\x98\x93\x89\x58\xcf\x0b\x99\x15\xc8\xc9\x53\x09\x65\x2d\x23\x3c\x73\x92\x0d\x5f\xeb\x53\x13\x51\xc8\xbe\x6b\x1e\x0b\xba\x26\xc6\xb1\x96\xda\x73\x63\x90\x5d\x4f\x41\xe9\x5e\x48\x08\xfe\xe2\x0e\x90\xba\xfd\xf5\x99\x80\x77\xed\x8e\xc1\x84\x2b\xec\xdf\xbc\xb0\x27\xed\x34\x2e\xa9\x09\x45\xa3\x2d\xfe\x6b\x13\xc2\xf6\xbb\xee\xdd\xed\xa0\xb5\xca\x3b\x29\xf1\x04\x76\x93\xc8\x8d\x5e\xbb\xd6\x61\xe7\x26\x9e\x99\xbb\xeb\x31\xf4\x55\x92\x7d\x16\xaf\xde\x0e\xeb\x6a\xe7\x1b\xdb\x2d\x2d\x4b\xfa\xd8\xdb\x7a\xb9\xb5\x90\x3c\xcf\xe9\x12\xa5\x43\x5e\xa2\xc6\xab\xf8\xbf\xe9\x7e\x40\x04\x00\x99\x1a\xa9\xe2\xf2\x9f\x56\x92\xff\xef\x69\x67\xbf\x99\x84\xc5\x0e\x90\xf6\x18\x04\xf7\x89\x26\x56\xd4\x51\x72\x46\xbf\xd7\xd9\xf2\xc9\xc6\xd7\xe2\x0e\xb9\x5c\x57\xf3\x2e\x34\xdb\xbb\xfd\xbb\x16\x71\x07\x36\x76\xb6\xb4\x1e\x1d\x63\x9d\x1f\xa5\x41\x92\x44\x51\x1c\x8f\x02\x2f\xa0\x13\x6f\xe2\x84\xa1\x1b\x41\xe4\xa5\xde\x78\x1c\x47\x29\x3a\x6d\x46\x63\x9f\x86\x11\x44\xe1\x24\x84\x38\x4a\x80\xfa\xfe\xc4\x8f\x3d\x77\xdc\xdf\x89\x87\x96\xd9\x1e\x8a\x8b\x27\x1a\x6c\x77\xee\xcc\x91\x7b\xd2\x1f\x39\x93\xdd\xa4\xc3\xac\xaf\xc2\x43\x15\x4a\x60\x45\x97\x86\xd0\xdb\x40\xcf\x33\x68\xe0\x47\xb9\x11\xce\x2c\x36\x37\xb9\xcf\x0e\x21\x59\x99\xfd\x51\x73\xb5\x72\x31\x2f\x49\x1f\xf9\x73\x1f\x19\x29\x41\xd5\xd2\xf2\x6a\xa5\x17\x4f\xed\xc9\xb6\x97\x60\xf8\xd2\x1a\xe1\x8c\x57\x3d\xcf\x9b\xd6\x39\xb1\x43\xad\xcd\x4a\x6b\x86\x42\x89\x30\xcf\xd1\x02\x08\x8b\x18\x18\x12\x8d\x55\x81\xb2\xdf\xb4\xd9\xcd\x54\xdb\x00\x09\x06\xfb\xa0\xe4\x8e\x31\x3b\x4c\x0c\xcf\xc2\x42\x5e\xbd\x4f\x7e\x0f\xd5\x3a\xf2\x74\x1c\xce\x17\xf0\xb7\xb9\x01\xbb\xbe\xd9\x58\xd8\x46\x13\x72\xfd\x41\xd8\x6f\xb6\x7f\x76\x76\xf7\x14\xd3\x79\x92\x41\x1c\x44\x75\xb7\x29\xa8\x17\x8d\xe2\x98\x8e\x1d\x48\xc3\x30\x8c\xa2\x49\x9a\xba\xd4\x0f\x42\x60\x4e\xec\x47\x6c\x0c\xe3\xc0\x0b\x42\x77\x34\x0a\xc3\x64\xe4\x30\xf0\x23\x16\xba\x09\x30\x16\xa4\x93\x94\x8e\xc2\xb0\xff\x3f\x76\xcf\xab\x73\xbb\xe3\xdc\x6f\x9c\xf7\x97\xdd\xf9\x3d\x0b\x7e\xd8\xfa\xed\x0a\x06\x39\xac\xf5\x4e\xbf\xe3\xf6\xaa\x19\x42\x6a\x74\x84\x5e\x37\x66\x6e\xf5\x53\x18\x5f\xb9\xef\x8d\x7d\x6f\xd4\xdb\x11\xca\x71\x5e\x71\xa0\x0e\x20\xf0\x43\x7f\xeb\xcd\x92\xa2\xb9\xb1\x8e\x12\x40\x39\x24\x0e\x7d\x87\xc5\x6c\xe2\xa4\xc0\x9c\x09\x73\x83\x71\x9c\xb2\xd4\xf7\x93\xc4\x01\x60\xa3\x10\x12\x27\x88\x26\x7e\x94\x06\x00\x61\x1c\x26\xae\x47\x47\x40\x27\x51\x47\xb4\x84\x6c\x7a\xfe\x7d\xdf\x0b\xc2\x49\x47\x68\xc6\x8c\x8a\x5f\x50\xf9\xb9\x22\xae\xeb\x8d\xfd\x71\x38\xd9\xfa\x24\x86\x02\xd2\x2c\xc9\x94\x69\xa9\xef\xac\xe3\x91\x33\x19\x25\xde\x38\x8d\x02\x16\x78\x51\xca\xd8\x38\x74\x69\x9a\x8c\x9c\x30\x4c\x1d\xe6\xb8\x93\x80\xa6\xf1\xa8\x23\xac\xc5\x98\x41\x77\x85\x89\x48\x2e\x69\xfe\x39\xe1\x25\x46\x5c\x38\xde\x64\x12\x6d\xc7\x99\xc8\xb5\xc0\x70\x4b\xb5\x66\xd1\x84\xa5\x6c\x92\x26\xcc\x75\x92\x09\x8c\x7d\x16\x44\xe3\x89\x97\xa4\x51\x3c\x1e\x39\xb1\x17\x39\x71\xe8\x31\x3f\x72\xe3\x28\x88\xc6\x9e\xef\x79\xfe\x64\xe2\xa5\x3e\x38\x13\x1a\x39\x41\x1c\x77\xac\xd9\x5a\xfc\x0b\x50\xb9\x2a\x41\x5c\x91\x6d\x00\xd1\xfa\x02\xf5\xf0\x41\x9c\x24\x01\xf3\xdc\x51\x9c\x4c\x58\xc4\x1c\x06\x2c\xa6\xae\xe3\x7a\x34\xf0\x93\xc8\x77\x43\xe6\x4e\x12\x98\x84\x69\xe0\x24\x11\xf5\x20\x1d\x27\xe3\x49\x1c\xb3\x91\xc3\x46\x5e\xe0\x6e\x0f\x6f\x4f\x7a\x35\x84\x3b\x0e\xa3\x10\xbc\xb1\xef\x27\xa3\xd0\x81\x88\x06\x51\x04\x41\xc2\xdc\x90\xba\x00\xae\xc7\xa2\xd1\x18\xa9\x2e\x1b\xa7\x91\xc7\xbc\xc4\x75\x26\xe0\xb1\xc0\xf3\x02\x16\xc1\x78\xd4\x11\x0a\xa4\xfc\x80\xa5\xea\x9c\xc6\x61\xec\x85\x69\x32\x81\x90\x79\x93\x74\x92\x7a\x30\x8e\x99\x1f\xb8\xe1\x28\xa4\xe3\xb1\x3b\x66\x4e\x92\x78\xac\x03\xce\x4c\x93\xca\x0d\x63\xf1\xa1\x94\xf0\xe2\x3c\x5c\x03\x05\x4f\xbc\x4f\x7f\x09\xf7\x95\x10\xb2\xcf\x57\x53\x5d\xd6\x6f\x48\x7c\xff\x92\xe5\x68\x71\x50\x3d\xd8\xcb\xf9\x7b\x84\xbe\x8f\xd5\x77\xca\x70\xb6\x2c\x39\x5b\x25\xda\xb2\x31\xfd\x74\xf3\xb7\x5f\x3e\xfd\xac\x6e\x3f\x7d\xfc\xf3\xbf\xb7\xad\x73\x95\x1f\x63\x59\xae\x0a\x10\xba\x07\x74\xfc\xa2\x34\x26\x05\x5a\x33\xa1\x40\x89\x89\x3c\x64\x05\xe3\x0f\x03\x2d\x23\x36\x4c\x87\xc6\xb9\x53\xaa\x53\x6d\x74\xea\x12\x68\x32\x6f\xf2\xe9\x18\x52\x6e\xae\xa1\xe8\x7e\xba\x2c\x87\xae\xd3\x80\xed\xae\x36\x9a\x76\x5a\x57\x73\x3e\x43\xdb\xea\xc1\x76\xd2\x1b\x2a\x04\xc9\x24\x5a\x12\xa7\xba\xdf\xa9\x31\x6b\x55\x43\x62\x4b\x6b\x13\x46\x93\x27\x7a\x4f\x17\xca\xda\x8b\xd3\xd5\x16\x20\x74\x0a\x69\x8b\xad\x90\xf4\x51\x90\x14\x81\x42\x13\xa4\xd0\x8e\x8d\x12\x66\xb4\x64\xb9\xf1\x87\x98\xa6\x0c\x96\x72\xfe\x5a\x9d\x1c\x88\x38\x1a\xd9\xfa\x67\x11\xbd\x9f\x6b\xbd\xa9\x71\xda\xfe\xcc\x61\xfd\x14\x2a\x34\x4d\x3b\x05\x57\x61\x0a\xd5\x7b\x1d\x04\xa0\x82\x0e\xf4\x9e\xd1\x42\x4b\x24\x83\xde\xc6\x40\x64\x49\x45\xcb\x5c\xbf\x8d\x2c\x24\x55\x4b\x85\xe8\x31\x03\xd9\xd6\xe8\x87\xbd\x56\x67\x3b\xf5\x89\xbd\x92\x6c\x53\x7e\x1d\x25\xa1\x13\x44\x26\x4a\xaf\xff\x52\xea\xcd\x3e\x19\x6d\xa7\x6c\x76\xb2\x10\xac\x08\x55\xbf\xb7\xd5\xea\x49\x39\x76\x77\xec\xd6\x7e\x04\xff\x85\xcf\xf6\x85\xdb\xaa\x1b\x0e\xa7\xf4\xfb\x41\xdd\xc5\x65\x1b\xf3\xe9\x8f\xdc\x3d\x07\xa5\xb6\x3a\x2a\x0a\x09\xc2\x12\x46\x45\x76\x4b\x90\x34\x6b\x98\xa3\x91\x2e\xd7\xbc\xc4\x66\x6a\x79\x16\x3b\xd9\x4c\xf7\xb2\xe7\xf4\xdd\x35\x3f\x35\x6e\xb0\x04\x7d\x6e\x8c\xf0\x82\xfc\xf9\xe3\x5d\xd5\x19\xe2\xf1\x77\xae\xf2\x9d\xab\x34\xb8\x8a\x45\x9e\xef\x8c\xe5\x3b\x63\xf9\x4a\x8c\xc5\xa2\x5c\xbf\xb7\xd5\xf0\xeb\xf1\x96\x97\x23\xff\x4a\x11\xb8\x44\xa2\x26\x4e\xe3\x00\xef\x66\x33\x24\x23\x12\x0e\xd6\x29\xde\x2b\xcf\x62\xfd\x35\x59\x60\x0a\x8d\xac\x98\x35\xf1\x35\x7e\x24\xb3\x92\xaf\x96\x62\x40\x20\xc3\xe0\x44\x43\xba\xf5\x3c\xe3\x55\xf2\x05\xa4\x40\x6b\xb4\xee\x07\x16\x99\x44\xbb\x78\x8d\xd2\x53\x6d\x6e\x16\x53\x24\x89\xa0\xc5\x2f\xdb\x23\xad\xe3\x32\x1c\x87\xd0\x8d\xc0\x0b\xed\x5e\xc7\x6b\x1d\xa6\xc1\x46\xb0\x86\xb9\x8d\x58\x5d\x2b\x24\xe4\x67\xfd\x19\x4f\x2b\x38\x54\x93\xac\x50\xfb\xb4\x19\x57\xa2\x3c\xab\xaf\x94\xc0\x2a\xb1\xe3\x33\x62\xc3\x39\x49\xec\x6f\x7f\xfc\x9f\x38\x6d\x6a\xc6\x5f\xe3\xb8\x59\xd9\xe6\x3c\x27\xee\x08\xb1\xeb\xbd\xb9\x16\xdc\x12\xbe\x50\x12\x58\x2d\xaa\xa7\x25\x46\xc4\x2c\xf0\xc3\x13\xce\x64\x35\x92\x39\x9b\xe8\xc8\x45\xe7\x8c\x3a\xa4\x25\x24\xd9\x32\x43\x4c\x1b\x9e\xe5\x60\x56\x83\xed\x3f\xa0\x8d\x83\xd9\x09\xcc\xb7\x7a\x4a\x2d\x4f\xfa\x7e\x50\x5f\xec\xa0\xda\xa0\xdb\x67\xa9\x45\x54\x4a\x58\xa0\xdf\x52\x05\x05\xea\x0e\x89\x5c\x3f\x71\x54\x55\xb2\x01\x13\x69\x8f\x57\xdf\x9b\x4d\x51\x8b\xa8\xf4\x25\x75\x13\xc0\x0e\x32\xd0\x71\x69\x26\x06\x05\xa9\x0e\x29\x57\x85\x51\x5a\xa6\x17\x17\x38\xab\x0b\xdb\xd3\xd4\x22\x36\x21\x77\x18\x9b\xc7\xbf\x60\x5a\x07\xb4\x9d\x01\x23\x4b\xaa\x32\x2b\x29\xa8\x51\xf4\xd4\x79\x2a\x8c\x2e\xa5\xfb\x4b\xca\x4c\x42\x99\x51\xfc\x64\x2a\xd7\x9f\xf4\xa5\x09\x75\x50\xa7\x92\x96\x33\x90\x53\x1b\x30\x24\x40\xfe\x4e\x94\x38\xb3\xd0\x67\x50\xe4\xaa\x21\x2b\x71\xfc\x49\x45\xee\x95\x52\xa2\x5b\x83\x50\xdf\xb6\x42\x56\x1d\xa1\xef\x4a\xd9\x57\x55\xca\x9e\xd2\x9f\x34\x29\xe9\x7e\xf7\xc4\x0a\x74\xe0\x45\x7d\xa3\xc3\x74\x3c\x50\x57\xa0\x55\xf4\x4a\x95\x79\x2d\x29\x81\x36\xee\xc1\xed\x5b\xd8\xe7\x5c\xeb\x26\x46\xd0\x3a\xd7\xdc\x10\xc3\x31\x42\x17\x11\x0c\x6d\x68\x55\x2e\x96\x7a\xd2\x07\xcc\x68\x14\xa6\x2c\xf6\x13\x3f\x1d\x8d\x83\x64\x13\x5d\xbe\x15\xb5\x16\x59\xdf\x65\xa1\xb3\x88\x5f\x2e\xa1\xa2\x24\x7b\x82\xa3\xaa\xac\xd4\x5d\xa1\x51\x09\x2f\x0a\x75\x89\x8e\xa8\xce\xce\x42\xe2\xce\x7a\xf4\x4e\x92\xa5\x6e\xc0\x88\x8f\xe6\x56\xd9\x1a\x2f\xcc\x28\xad\x64\xf5\xf4\x7a\x35\x52\x71\x77\xad\x98\xb9\x7e\x63\xb8\x6c\xaf\x63\x31\x6a\x12\xad\xc4\x6c\x25\x6a\x34\xae\xf1\xa0\x1c\x51\xf0\xe2\xa2\xe3\x66\x0f\x86\x20\x73\x9e\x0f\xec\x95\xc4\x0b\x7d\x61\xd3\xf6\xa3\x7d\x68\x28\xd6\xdb\x68\xfe\xf8\x91\x4c\xd5\xdf\x37\x50\x9a\xcc\x3a\xd3\x06\xd3\xff\x58\x0f\x81\xe0\x9a\x0c\x43\x69\x09\xc2\xdc\x08\xb3\x23\x92\x25\x94\x19\x67\x98\x0b\x3a\x7f\x1c\x10\xc1\xf1\xce\x6b\xfe\x88\xe2\x91\x16\xea\xc8\x82\x3e\x62\x6c\x9a\x1a\xc2\xdc\x2d\x68\xcf\x41\xcc\x79\x29\xf3\x6f\x2d\x0b\xda\x0d\xe7\x39\x62\xca\xaa\x8d\x2a\x72\xfd\x6c\x3c\xa9\x93\xea\x3c\x21\x11\x6f\xe0\x41\xc2\x17\x46\x19\xc4\x20\x44\x06\xa8\xb7\xc6\x8f\x84\xdf\x43\x69\x2f\xd4\xa9\x8b\x6c\xea\x4a\x05\x99\x67\xb3\x39\x72\xda\x9c\x57\x17\xdc\xeb\x38\xca\xab\x83\x82\xe5\x35\x8e\xed\xda\x16\xb9\x36\x1f\xe0\x28\x95\xaa\xdc\xf8\xfa\x94\x88\xf8\x2d\xd2\xff\x1c\xce\xf3\x7b\x56\x0a\x4d\xde\xa8\xbb\xf5\x26\x76\xaa\x44\x59\x97\x0f\x73\x23\x27\x6f\xef\x79\xb7\xdd\x77\x4f\x76\x8f\xa3\x31\xfd\xe3\x7a\x99\xe3\xf5\xa6\x87\xf9\x63\x3b\x87\x54\x66\xb3\x93\x59\xbc\x46\x44\x56\x9f\x65\x92\x3c\x50\x41\xe0\x3e\x4b\xea\x8b\x03\x3b\x8e\x05\x92\x26\xad\x12\xaa\xdb\xdf\xc0\x5a\x39\xee\xea\xab\x33\x43\x72\xc3\x85\x50\x45\x06\xf4\x7d\x6f\x61\xd3\xea\x93\x69\x7d\xc9\x9c\xac\x0a\x01\x52\xe6\xc0\x30\xc5\x7e\xba\xc2\x98\x20\x6b\xe0\x81\x74\x5a\xcb\xb9\xd3\xac\x10\xab\x14\xe3\xa3\x94\xe5\x55\xa5\x8d\xc3\x26\xea\xee\x3a\x86\xfb\x22\x69\x16\x9c\xd4\xd9\xa9\x09\xd9\x54\x00\xeb\x35\x68\x10\x75\x12\xaf\x5a\xb3\x47\x4b\x0d\x14\x12\xc9\xed\xd4\x3c\xaa\xef\xc0\xda\x70\x41\x14\x0e\x04\xa6\x16\x80\xe1\x6c\x48\xa6\x0a\x60\x9c\x42\x35\xe2\xd4\xe8\x96\x79\x96\x82\xcc\x16\x58\x28\x60\x8a\x38\xa2\x2f\xdd\xe2\xff\x10\x0c\xca\xf8\x52\x51\xe9\x69\x6b\x2b\xf0\x22\x3c\x29\x50\xc9\x41\xd2\x5e\xef\x57\xc7\xcc\xde\x99\x49\x95\xb0\xcc\x69\x62\xec\x50\x05\x57\xb6\xeb\xe6\x0d\x67\x95\x36\x40\x13\x8c\x81\x4e\xde\x33\xe8\x4c\x06\x60\x72\xce\xd7\xf9\x00\x70\x75\xa8\x24\x39\x50\x51\x5f\xdd\x70\x9d\xff\xad\x28\x1c\x94\xdb\xf4\x6f\x80\x8b\x65\xf8\x13\xaf\x56\x59\x6b\x32\x7a\xd5\xf0\x83\xa9\x05\x58\xcf\x7c\x9a\x60\x95\x85\x1c\xd1\xa0\xba\xd0\xa2\x3e\x30\xa8\x6b\x53\xeb\xa1\x71\x70\x40\xb2\x21\x0c\x71\xa1\xe6\xd4\x92\x70\x42\x0a\x5e\x59\x2f\x4a\x6d\x94\xb0\x57\xa4\x6d\x6a\x68\xab\x5c\x99\x4b\x5e\x7a\x31\x86\xe4\x56\x8d\x03\x68\x7c\x40\xc0\x4c\xf1\x14\xfc\x26\x13\x1a\x41\xb0\xb7\x81\xe2\x01\xc8\xe2\x0b\x5e\xaf\x03\x03\xb6\xd2\xbc\x4f\x33\x03\xb5\xb0\x16\xb9\xd4\xf2\x2a\x9e\x4d\xcd\x64\xf4\xdd\x93\x6a\xaa\xb8\x75\x98\x5c\x42\x48\x13\x60\x6e\xce\x87\xda\x44\xbd\x85\x28\x56\xe2\x0d\xae\xe4\x4b\x43\x1b\x6f\x6c\xbf\xb9\x30\x59\xe9\x94\x59\x0b\xd1\x8d\xbd\xb4\x89\xec\x85\x36\x45\x2a\x04\xb7\x18\x6f\xb0\x58\x25\xd8\xa8\x41\x68\x4c\x62\xf8\xfa\x28\xfa\x5e\xe2\xcc\x79\xfe\xeb\xfc\xb1\x45\x9a\x15\x82\x63\x02\xcd\xe7\x8a\x0f\x55\x47\x07\x09\x9a\x88\x34\x4a\x1e\xe0\x65\x9d\xc8\x03\x31\xc5\x50\x0c\x6b\xe7\x02\x6b\x4d\xfe\xbb\x16\xfa\x1b\x22\xa0\xb1\x01\x54\xc3\x2e\xa0\x9c\xa1\x01\x2b\x13\x52\x90\x14\xa4\x4a\x22\xd0\xba\xc6\x89\xe2\x88\xe0\xab\x32\xc1\x24\x02\xb4\x68\x0e\x62\x76\x95\xe6\x39\x7f\x50\xbd\xe1\xa8\x86\xd2\xe0\x08\xcd\x8b\xb5\xd5\x1f\xd7\x29\x59\xae\xe2\x3c\x4b\x54\xee\x55\x05\x68\xc2\x8b\x34\x9b\xad\x4a\x44\x1e\x5a\x41\xa1\x7a\x34\xa8\x2e\xda\x97\x10\xf1\xa6\xb1\x35\x15\x62\xd0\x04\x16\x34\x51\x6d\x30\x50\x92\x22\xd5\xaf\x69\x67\x73\x52\x18\x9b\x8d\xa5\x8e\xac\x56\xa9\x86\x50\x54\x58\x71\x83\x35\x99\x0e\x45\x36\x9b\x2a\xa2\x63\x72\xaf\xa0\x50\x56\x54\x6a\x28\x82\xfc\x0d\xe2\xef\x4f\x76\xbf\x35\x16\x8b\x66\x1d\x21\x7d\x0f\xe8\x49\x44\xde\xae\x3d\xd4\xc0\xe7\x37\xbf\x42\x2c\x70\xc3\xe5\x5b\x5b\xa4\x28\x86\x3a\xbf\x85\xfd\xbe\x96\x4b\x9a\x3d\x77\x01\x5f\x7f\x79\x79\xc3\x45\x26\x37\xd3\x7b\x10\xf2\xfa\x36\x61\xa7\x7b\xfe\x62\xef\xfe\xec\xbc\xfa\xb0\xbf\xd9\xa7\x58\xf0\x1c\x64\x47\xb0\xf0\x7e\x8b\xd4\x53\x71\xbe\x1b\xcb\xd5\xf8\x1c\x6f\xb8\x74\x36\xd8\x27\x0f\xef\x95\x89\xf7\xa8\x0a\x84\x74\xab\x0d\xe7\x89\x40\x6e\x1f\x80\x46\x28\xf2\xf9\x0f\x80\xea\x5c\xf4\x3a\x96\xb6\xa6\xeb\x26\xbe\x89\xca\x4c\xa4\x8f\xb5\x63\x22\x2b\xb4\xdb\x60\x8b\x88\x9e\xf3\x1c\xd5\x79\xb7\x91\xae\x57\x0f\xbb\x32\x6f\x1f\xa5\xeb\xb5\xa6\x6a\x58\x06\x52\xd1\x56\xac\x83\x92\xd6\x36\xf3\x76\xd7\xfc\x45\x72\xed\xc3\x55\xd9\xa1\x34\xc9\x5e\x6c\x81\x2d\x9d\x17\x02\x5a\xf2\x65\x96\x38\x15\xcc\x9d\xb0\xaa\x6f\x0e\x05\xd4\x7d\x49\x40\xdd\x33\x02\xea\xbd\x24\xa0\xde\x19\x01\xf5\x5f\x12\x50\xff\x8c\x80\x8e\x5e\x12\xd0\xd1\xf9\x00\xa5\x71\xf6\x42\x90\xd6\xd4\x0e\x7f\xdf\xfd\x74\x4d\xde\xfc\xeb\xe7\x4f\x7f\x32\xd7\xf8\xdf\x1a\x88\x0c\x79\x90\xdc\x84\x1f\xeb\x63\x05\xcc\x90\x51\xa5\x81\x0e\xc9\x54\x3a\x53\x9b\x5b\x41\x58\x0d\x4c\x7d\x81\xd7\x7b\xb3\xb4\x35\x54\xfd\xce\x68\xeb\xb4\xe0\xc5\xe3\x82\xaf\xc4\xf0\x77\x23\x43\xec\x8c\x1c\x7f\x19\x19\x62\xb7\xf3\x64\xdf\x68\x5b\xae\x93\x03\x63\xcd\x8f\x8f\x34\xb7\x3f\x8d\x07\xdb\x5c\xdf\x46\x0c\xbd\x14\xe3\xb7\xfd\x9f\x87\xf7\xbf\x0c\xcb\xb7\x61\x0f\x2f\x74\xe6\x95\x0a\x55\x5a\x86\xae\x8e\xf8\xda\x4c\xb8\x4a\x75\x24\x6d\x7e\xcc\x14\xca\x2d\xf8\xd0\xd8\x02\xe5\x0b\x41\xd7\x04\x8b\x7f\x81\xc2\x04\x7b\x6d\x01\x51\xc5\x5a\x7d\x2d\x38\x36\x07\xfc\x16\xe8\xd3\x73\x42\x90\x5f\x29\x99\xda\x26\x19\x31\x50\xf9\x12\xe4\xa2\x51\x57\xae\x8f\x91\x98\xb4\xba\x36\xbe\x97\x68\x98\x33\x64\x7b\x47\x04\xaa\x55\x6e\x6d\xab\x89\x73\xce\x17\xc6\xa1\x83\x36\x14\xaa\xd2\xd6\x2e\x91\x2e\x98\xfc\xb1\x84\xa6\xa9\xb6\x12\x19\x3c\x04\xf1\x12\x34\xe7\xf7\x80\xc3\x3f\x01\x95\xfd\x13\xda\xd5\xf8\xdb\xc1\x85\x94\xd3\xfa\x25\x90\xea\x60\xcf\x64\xed\x6f\x6e\x7a\x83\xb3\xc2\xc8\x55\xc6\x13\x3e\x20\x31\x28\xa7\xe5\x86\xc3\x07\xdb\x95\xb0\xe0\xad\xc4\x97\x38\x27\x15\x93\x12\x03\x82\x60\x2c\xc3\xa4\x91\x75\xcc\x5a\x86\x87\xda\xe7\x60\xfc\xce\xcb\x6c\x09\x8c\x2c\x30\x40\x42\xce\x29\x66\x14\x4c\x00\x9d\xd0\x68\xe3\x33\xf1\x6e\xc9\x1c\xc3\x29\xc4\x46\xcc\x9b\x59\x54\x4c\xc8\x86\x89\x38\x33\x51\x47\x3c\xd8\xdc\xab\x92\x73\x22\x72\xfe\x80\xf2\x22\x3a\x65\xb2\x7b\x30\xb6\xff\x7a\x3c\xd7\xf1\x46\xcd\x85\xb3\x26\x7a\xdb\x80\xed\x3f\x1a\xcf\xf6\xbe\xbe\x26\x87\xeb\xa9\x79\xf1\x74\x5c\x12\x12\x23\x54\x34\xac\x17\x25\x35\xb8\xf4\x92\x33\x34\xf1\x61\x9d\xbf\xa3\x71\x00\xc1\x38\xf4\x82\x30\x9c\x1c\x36\x43\x9b\xb1\x62\xd7\x3c\x1f\xe6\xa0\xfc\x21\x36\xd1\xab\x71\x93\x28\x14\x7e\xe6\x2c\x63\xce\x73\xa0\xc5\xeb\x23\x9d\x07\x39\xb1\xff\x1d\x84\xa8\x4a\xce\x31\x88\x57\x33\xac\x5a\x91\x40\x79\xc0\x5d\x81\xba\x34\x7d\x83\xbe\xbd\xc7\x78\x36\x4c\x8b\xaa\xbb\xe9\x6d\x4f\x79\x23\x19\x73\x2b\x58\xec\xf5\xc5\xbd\x27\x50\x7e\x52\x5b\xd5\x37\xba\xc9\xeb\xdb\xe8\x56\x8a\xbd\xad\x7d\x6c\xba\x0c\x8e\xde\x4d\xb5\x00\x36\x02\xba\xd7\x31\xab\x9a\x33\xc5\x8f\xda\xd5\xa9\x6e\xbf\xa2\x1f\xaa\x11\x41\x65\x72\x6d\xa2\x90\x83\x50\xe1\x17\x18\x61\x67\xfc\xdb\xc0\x2c\xe5\x31\xf1\xd6\x46\x23\x4b\x90\x41\x14\x42\xa2\xd3\x54\xbb\xc2\xaa\x58\xef\x66\x48\x33\x82\x58\x36\x93\x7d\xd9\x01\xb1\x6a\x08\x79\x6f\x5c\xc1\x75\xe2\xcb\x2a\xba\x1d\x1d\x53\x98\x89\x17\x89\x81\xe5\x1d\x15\x44\x72\xbe\xd2\x16\x08\x05\x08\x1b\x7e\x03\x08\xfa\x5a\x31\xf3\xc8\xe0\x9b\xbd\x19\x23\x9f\x52\x23\x30\xc1\xcc\xf5\x87\xee\x37\x3b\xf9\x12\x21\xdd\x3c\x6a\x82\xd9\x67\xc6\x5e\x40\xc3\x80\xc2\x38\x70\xbc\xd1\x28\x0d\x26\x51\xe4\x8c\x93\xc4\x71\xdc\x49\x18\x7a\xa3\x20\x89\x27\x5e\xe2\xc5\xa3\xd4\x05\x2f\x0e\xa9\xe7\x8c\x60\x34\x1a\x8f\x9c\x09\x74\x5a\x4f\xea\x3a\x50\x3b\x00\x78\xca\x41\xb3\xb1\x79\x0a\x3d\x4d\x81\x04\xe4\xdc\x5d\xe7\x6a\x47\x3f\x7b\x5d\x3d\x1b\xfb\xb0\x4d\x56\x30\x2e\xf1\xaa\xd7\x2d\x5f\x75\x8b\xd8\x9d\x49\x08\x1b\x8a\xc7\xc9\xd4\x09\x41\xe9\x75\xac\x4d\x4d\x9c\x78\x9d\x36\x9f\x9b\x6b\x22\x2a\xf8\xb2\x33\x89\xff\x80\xe4\xd9\x17\x2b\x3b\x9b\x2b\x69\x0b\xf4\xdc\x4f\x6f\x3e\x7d\xbe\x6b\x54\x66\xfd\xb1\x79\xad\x65\x5e\xb5\xe0\x18\xd7\xc1\xf1\x96\x98\x6c\x5c\x23\xab\xc8\x4e\xbb\x68\xf8\x6b\x24\x28\x58\x3a\xfb\x9b\xa4\x29\xcf\x3f\x19\x87\x51\xa5\xfa\x34\x98\x2a\xa0\x17\xea\x4a\xe2\x89\x4c\xb6\x0a\x30\xb1\x25\x45\x9b\xf7\x1b\x77\x23\x74\xb3\x96\xab\x62\x9c\xba\x14\x85\xb1\x0c\xbc\x4e\xf4\x32\x15\x8e\x6f\x71\x82\xaf\x16\xc3\x0e\x9d\x80\x36\x11\x94\xcb\xe4\xe9\x7d\xbf\xbd\x79\xbf\xb9\xeb\x1f\x51\x21\x81\xd5\x42\xd9\x78\xa8\x54\xd1\x92\xe8\x64\xb9\xa8\xbf\xdd\xb1\xf7\x02\xca\x7b\x94\x68\x94\x22\xad\xb5\xb7\xaa\x33\xdb\x03\xf1\x86\x0e\x79\x77\x73\x3d\x20\x31\xc7\x88\x99\xac\x98\x99\x38\xf7\x18\x9d\x34\x16\x2f\x74\xec\x91\xad\x51\xd4\xd0\xd3\x3f\xaf\x96\x4b\xae\xc8\xd5\x02\xe4\x9c\x33\xfd\xe1\x14\xe4\xfc\x6f\xca\xf4\x75\xad\x82\x36\xf1\xbf\x8d\x32\x79\xf6\xd1\x0c\xa4\x0a\x94\xf8\xe9\x71\xd7\x73\x2c\xee\xdb\x0c\x99\x34\x6f\x1b\xc5\x5e\x4c\xb6\xc2\x66\x53\x5d\x38\xb8\xf1\xe4\x3d\x67\xcd\xff\x9a\xbd\x79\x27\xed\x33\xe4\x0b\xe6\x92\xa0\xf9\x04\xef\x4e\x36\xa3\xf1\xef\xe6\xca\x4f\x5c\xe0\xfc\xf5\x14\x17\x74\x89\x66\x0d\x2a\x48\xca\x31\x54\xaa\xb1\x8d\x84\xfc\x68\x02\x27\x33\x66\x05\xcd\x2a\x90\xb2\xf5\x95\x5c\x93\x29\x86\x30\x4d\xed\x67\x95\xd1\x60\x40\xa6\x92\x23\x7c\xea\xc2\x8c\x01\x2e\x2b\x96\x2b\x89\x75\x5d\xb1\x98\x4a\x83\x65\x68\x4e\x61\x02\xb9\xf2\xbc\x62\x61\x08\x27\x46\x43\xd5\xb1\x7a\xb0\x96\x25\x25\x53\xf3\x81\xc9\x48\xbb\x05\x52\x55\x19\xc5\x82\x05\xca\x9c\x98\xdd\x43\x5d\x88\x85\x2a\xd7\xdb\x74\x49\x33\x46\x2e\x6d\x3a\xc1\x66\x2e\xec\x1f\x6d\x0e\x3d\x32\xd5\x56\x1e\x35\xc9\xa9\xb3\x76\xaa\xf8\x4d\x1b\x97\xaa\x19\x9e\x29\x6d\x95\xf3\xd9\x75\xc1\x60\x5d\xad\xc9\xd2\x18\x1f\x2d\x2d\x33\x7e\xbf\x86\xc6\xd0\x1a\x75\x13\x0d\xcc\x4d\x39\xa1\x52\xf8\x54\x45\xa5\xff\x7c\xf7\xc7\x4f\xb6\xec\x96\x09\x41\xa6\x82\x7c\xbc\x7d\xef\x39\xc6\x60\x6f\x46\x8b\x57\x59\x2e\xb3\x82\x7c\x54\xe1\xc4\xd5\x7d\xac\xd6\x90\x0a\x08\xa5\xf6\x92\xa9\x4e\x37\x8c\x3b\x67\x4c\x4e\xf8\xa7\xa0\xa9\xdd\xc3\x34\x2b\x68\x9e\xfd\x03\xa3\x58\x51\xf9\x29\x21\x85\xb2\xa3\xa8\x40\xd5\xbf\x9d\x8e\xc2\xc8\xca\xdb\x79\x4f\xb3\x1c\xad\x75\x76\x25\x31\x08\x14\x5f\x0a\x49\xcb\xca\x08\x3c\xbd\xb8\x10\x5f\xb2\xa5\xba\x61\x5b\x49\x20\xaf\x8c\xd0\xdf\xde\xbc\x37\xd5\x39\xbe\x31\x02\xaf\x00\xd7\x90\x5a\xc8\x95\xfe\x34\xda\x0d\xa8\xde\xef\x06\x3d\x2d\xb8\xcc\x52\x03\x98\xe8\xf5\xea\x51\xb0\x0b\x33\x10\xfe\x49\x6c\x19\xfa\xab\xde\x6e\xdd\xc6\xa0\xf6\x55\x6f\x53\x16\xd9\x52\x63\x5a\x40\x99\x66\x48\x20\x56\x45\x26\xc9\xaf\x1f\xaf\x07\x64\x59\x02\xde\x37\xb5\x88\x34\x87\xf5\x7e\x23\xdd\x28\x4c\x53\x37\x9d\x38\xbe\x17\x52\xea\xa4\x51\x63\x49\x74\xf5\xf6\x63\xa1\xd2\xad\x14\x50\x59\x71\x22\x50\x49\x1a\x78\x23\x77\x1c\xb1\xf1\xc4\xf5\x27\x8d\x1c\xa8\x73\x2a\x90\x23\x5c\xf5\xf6\x9b\xe8\xf6\x1a\x07\xad\x40\x35\xc7\x8a\x79\xf5\x2d\xbd\x16\x0c\xfa\x52\x8d\x1a\xa5\x39\x5e\xd7\xe6\x25\x9d\xf0\xec\x9d\x5e\xe0\xe0\xef\xc8\x19\x7b\x81\xe3\x38\x91\x93\x32\xc7\xa1\x6e\x80\x55\x65\x69\x48\x43\xcf\x77\xc6\x91\xe7\x24\x9e\xcf\x7c\x0a\x1e\x4b\xa2\x80\x32\xd7\x77\xc6\x81\x4b\xbd\xc8\x9b\xb0\x28\x4c\xc2\x24\x8e\x46\xfe\xd8\x0f\xc6\xa3\x89\x17\x33\x77\x3c\x8a\x20\x0e\x21\x4c\x13\x27\xf5\x03\xdf\x8b\x61\xe2\x38\xde\x44\xc9\x2f\x84\x18\xb6\xb9\x6f\x1a\x8a\x59\x1d\x39\x0f\x6b\xcc\x3d\xf1\xc7\xed\xf7\x9a\x27\x44\x15\xa7\xdf\x07\xa2\x11\x7b\x8f\x04\xf2\x78\x3b\xbb\xad\x3b\x7c\xdc\x38\xe7\x4b\x7a\x5c\x27\xc8\x3d\x0e\x82\xf3\x55\xd0\xa6\x1d\x3b\xb2\x5b\x31\xeb\x50\xa8\x9e\x82\xf6\x2f\x7d\x67\x9d\x4e\x1c\xcf\x75\xa9\x33\x1c\x0e\xfb\x75\xb1\x17\xa3\x20\x9d\x3e\xf4\x3e\xca\x6f\xce\x81\xea\xbc\x7d\x34\x9e\x44\xbe\x2f\xf0\x78\xe4\x76\x58\x34\x3f\xf1\xc7\xed\xff\xc6\x67\xd3\xf6\xba\x3c\x79\x2b\x9e\x02\x53\x61\x41\x34\x76\x23\x27\x32\x58\xa0\xbe\xd2\x45\xe7\xaf\x7a\x1d\x74\xbc\x19\xfe\x8c\xe1\x04\x24\x2b\x52\xbe\x67\xd7\x0e\x3f\xca\xad\x61\x4c\x3d\x34\x86\x49\x43\xd2\x0c\x4a\xf2\x26\x7e\x94\x20\x7c\xef\x6d\xd7\x34\xce\x7a\xf8\x9b\x25\xc9\x7b\x4f\xd7\x34\xda\x51\x6f\xaa\x73\x3e\xa6\x42\xd6\x9b\x39\x64\xb3\xb9\xec\x9c\xca\x46\x5e\xf7\x8d\xe2\xe7\x47\xc2\x13\x8c\xf6\xc3\xb3\x2a\xb2\xb5\x4a\x9a\xa9\x12\xac\x77\x81\xd3\x48\xb9\xae\x5e\x1b\x8d\x71\x37\x7a\xac\x2b\xcd\xe5\x3b\x76\xfc\x4f\xc2\x0e\xfb\x4e\xae\x8f\xdf\xce\x26\x4d\xa9\x37\xb5\x6b\xc0\xb3\xdc\x77\xb0\xbd\xda\x58\xbf\xe7\x80\x6b\xee\x88\xbf\xd1\x81\x7d\xbb\xd0\x8f\xc5\x23\xc7\x0b\x47\x61\x18\x7b\x34\x4a\x61\x94\x44\x7e\x12\x30\x9a\x42\x98\x46\x41\x10\x46\x71\xec\xc6\x11\xc5\xea\x07\xaa\x03\x13\x70\x75\xd5\xeb\x18\x5c\x2b\xf0\xbc\x9d\x9d\xf7\x3b\x25\xfe\x1f\x45\x89\xbf\x9f\xb5\xb3\x9c\x35\xdb\x5a\x1b\xf4\x94\xdd\xec\xd8\x6d\xdd\x8d\x66\x19\x76\x57\xbb\xc4\x4c\x80\xe2\x0c\x75\x73\x34\x72\xe9\xeb\xcf\x39\x9f\x75\xcd\xc2\xf0\xda\x9f\xea\x98\x82\xee\x13\x6d\x6a\xc1\x9c\x0d\xe6\x53\x8f\x46\xc6\x0e\xd8\x56\x0b\x82\xa1\x1e\xfb\x61\x78\x12\x33\xcf\x47\x64\x54\x61\x9b\xb3\x2d\xe1\xed\x2f\x37\x04\x0a\xb4\x48\xd8\x9a\xbe\xd8\x3f\xda\x62\xd4\xbc\xbb\x66\xd3\xac\xa9\x53\xd5\xd2\x39\xdb\x7a\xea\x1e\x0d\x2c\xd7\x1f\xba\x00\x38\x6b\xd9\x1e\xf9\xaa\x28\x64\x55\x16\xe8\xcc\xc0\xd4\xe5\xdd\xdf\x60\x59\x55\x75\xdf\x1b\x1d\x1a\x49\xb2\x52\xc5\xfd\xd1\xda\x8f\xdf\xac\x30\xee\x0b\xa9\x40\x83\x8e\x89\xce\x23\xb5\x55\xb6\xa8\x59\xae\xe8\x6c\xd8\x60\x0c\x38\x08\x91\x35\xc2\xd5\xc1\x9f\x66\x6e\x25\x3c\xd0\x92\x75\xc1\x78\x52\xd1\x24\x5b\x2c\xe9\x6c\x3b\x70\xd8\x22\x77\xc1\xdf\x2e\xd7\xd4\x28\xd3\x74\x36\xd8\xc4\x6a\x81\x80\xd0\x3c\x27\xe8\x46\x13\xb2\xa4\xb9\x09\x3f\xef\x13\x81\x63\x75\xc1\xb5\x59\x24\xca\x16\x87\x3a\xdb\xb6\x97\x9c\x2b\x6b\xeb\x7c\x73\x95\x5a\x9e\x20\xd2\x05\xdb\x59\xeb\x53\x35\xeb\x52\x1d\xb9\xe6\xbb\x27\x27\x2a\x2f\x2a\x06\xc3\xa5\xa6\x7f\x12\x67\x52\x80\xec\x9a\x92\x73\x92\x9d\xef\x94\xa5\x36\x67\x4c\xb9\x96\x64\xe7\xd6\x9f\xb5\xfe\x96\xd1\xbc\xbf\x12\xf2\x58\x45\xbf\xf3\xa8\x9d\xb5\xe8\x97\x29\xf6\x75\xe4\x8c\x3c\x67\xd7\x8c\x10\xe3\x31\x2e\xf1\x61\xce\x6d\x52\x0b\x25\x8e\x6d\xfa\x43\x9b\xb3\x39\xbc\xca\x98\x1a\x55\x47\x44\xee\x13\xde\x24\x3f\x60\x42\x2d\xb0\xfb\xd5\x35\xa8\x5a\xae\xec\xca\x76\xc9\x60\x99\x73\x95\xed\xb7\xd6\xd5\xfa\x3b\xa6\x35\x76\xfc\x11\xa5\xe3\x89\xe3\x7a\xe3\x38\x18\x39\x9e\x4f\x1d\x2f\xf0\x5c\xd7\x8b\x27\x11\x0b\x3d\xf0\x93\x08\x46\x0e\x1c\x6f\x0a\xdd\x99\xc9\x52\x7b\x88\x25\x27\x71\x7d\xcf\xad\x04\xb6\x03\xc0\x3d\xd9\x2b\x19\x95\xf4\x58\x40\x54\x14\x80\x6a\x69\xd6\xa6\x93\x19\xf7\x9d\xb5\xd9\xc7\xbb\xf5\xbe\x3d\xcc\xd8\xd1\xe3\x57\x82\xad\xf5\xc8\x37\x4e\xd4\x0e\x50\xce\xa7\x85\xf1\xd3\x74\xb0\xae\xe3\x72\x08\xe0\xc7\xab\x62\x26\x87\x0f\x2f\x4f\x81\xb1\x6a\xac\x20\x55\xc1\x15\x08\x27\xca\x61\x29\x74\x52\x5f\x3c\x3b\x2f\xa4\x08\x20\x72\xa9\x2e\x3b\xf6\xb9\x8a\x00\x69\x68\x0b\x5d\xe0\xb9\x7e\x4d\xc2\x54\x0c\xcc\x1d\x9d\x1d\x0b\x61\xb4\x0b\x40\x95\x2c\x59\x41\xc9\x53\xa5\x97\x0a\x4b\x01\x77\xa8\x09\x7e\x43\x36\xc5\xcf\x6e\x21\x3d\x76\x97\x22\x35\x20\x26\x72\x84\x34\x5b\xe3\xca\x08\xbc\x40\x75\xa4\x72\x52\xa3\x4b\x9d\x48\xed\x7c\x1b\xd7\xaf\x3b\x25\x25\x18\x31\x53\xf2\x6a\xce\x83\xca\xdb\x1f\x6f\xe6\xa9\xa9\x80\x0e\x1b\xbc\xc7\x84\x0b\x9d\xe4\xbe\xd9\xe7\x49\xd3\x1c\xa6\x1e\xde\xc6\x1d\xbd\xe7\x90\x1e\xbb\x1a\x3b\x91\x24\xe1\x50\xe5\x04\x5c\x61\x6d\x7f\xc9\x49\x42\xf3\x64\x85\x91\x3a\x26\x88\xaa\xa0\x8d\xec\x74\x5d\xab\x51\xaf\xc5\x8c\x8a\x63\x41\xdb\x2d\x6b\x2b\xc5\x6b\xa1\x74\x18\xc4\x60\x8c\x25\xa0\x05\x32\x95\x84\x17\x58\x35\x4b\x01\x6b\x42\x51\xb5\xb9\xe5\x09\x8a\xd5\x56\x0f\x74\x0e\x45\xf1\xe9\x10\x72\x79\xa0\x24\x75\xfd\xa1\x8b\x18\x60\x4e\x7c\x65\x1c\x32\xd9\xc5\x95\xbe\xde\xfc\xc0\x40\x82\xa9\x17\xed\x14\x91\x70\x0d\xbb\xe6\xd0\xa2\x68\x2a\x5d\xde\x01\xe0\x57\xad\x91\xd9\x4c\x12\x6f\x1c\x82\x1f\x00\x0d\x20\xf4\xf0\xd6\xaf\xfa\xf2\x96\x3e\xec\xe7\x85\x25\x7d\x38\x60\xa8\x9d\x52\x81\x21\x83\x4f\xed\x91\xf2\x57\x06\x93\xc8\x8d\x69\xe4\x38\x94\x51\x36\x99\x8c\xac\xcb\x74\xdf\x4f\x38\x0a\xd2\xc8\xf3\x42\xd7\x89\x1c\xc7\x8d\xbc\xb1\xe7\x44\xf8\x57\xe2\xc4\xd1\xc8\x1d\x85\x13\x2f\x99\x8c\xfc\xc9\x78\x32\x72\x26\x91\xef\xf9\x13\xc7\x81\x60\x14\x3a\xe1\xc8\x4b\x58\x14\x86\x90\x4c\xd2\xc9\xc4\x09\xe2\x84\x3a\xe3\xb1\xeb\xc0\xc8\x73\x53\x3f\x76\x5c\x1f\x98\xe7\xb9\xbe\x37\x82\x30\x4c\xa8\xeb\x30\x7f\x14\x04\xb1\xef\xc5\x6e\xe4\x38\x49\xe8\x81\xeb\x85\xee\x24\xf6\x5c\x3f\x75\xd9\x28\xf1\x43\xc7\x77\xc6\xfe\x64\xc2\x98\x17\xd2\x74\x12\x78\x81\x17\x8c\x1c\xc7\xc8\x1b\x1f\xeb\xcc\x4b\xcf\x0d\xc1\x68\x2d\x35\xe2\x56\x43\xf9\xaf\x64\x45\x6d\x96\x34\x15\x60\x4d\xbc\xe2\x7d\x2d\x39\x7a\xce\xdb\xb3\x05\x75\xe8\xac\x2b\x27\xd1\xc1\x1d\x33\x7c\xa9\xe0\x8b\x03\x05\xcb\xf3\x0e\xae\x3a\x6e\x66\xef\xd8\x87\x05\x8d\x04\x5d\x87\xe3\x00\xde\x53\xb5\x04\x48\x75\xd0\x39\x97\xed\xbc\x04\xb4\x9c\x89\xed\xb1\x4c\x94\xbe\x7d\xa8\x30\x53\xdd\x73\xa7\xf9\x4d\x0d\x71\x3b\x26\x72\x67\xb4\xb5\x19\x66\x85\x4a\x8b\xc0\x34\x88\x26\xdf\x27\x42\xfc\x46\x9b\xd2\xb3\x94\xac\x0a\x9c\x02\x7b\x3b\x24\xd7\x5a\x2a\x6b\xd4\xfa\x49\xb2\x05\xcd\xcd\x02\x0c\x8c\x98\xd1\xce\xcd\x48\x5b\xc6\x17\xcc\x3a\xd4\x08\x83\xc3\x2e\x19\xac\x81\x35\xc0\xe0\x29\x61\x8f\x05\x5d\x64\x89\x3a\x62\xaa\x07\x75\x42\x94\x32\x8c\x61\x32\x26\x36\x58\x21\x76\x17\x39\x6e\x8d\xf7\x37\x0c\x56\x3e\xf1\xec\xe0\xbf\xbf\x49\x7e\xa2\xca\x86\xff\xfe\xa6\xc3\xcb\x48\xdf\x35\x04\xb1\xf1\xd3\xef\xed\xdd\x1c\x13\x3b\x58\xe5\x97\xc4\x25\xc0\x74\x45\x99\x30\x77\x7a\x6c\x29\x27\x85\x54\x24\xc5\xbb\xe9\x98\x4d\xb5\xd7\xac\x0c\xba\x0f\x9b\x75\x9a\x91\x6d\x14\xdb\x8f\xce\x96\x9c\x29\x61\x1a\xbb\xc0\x1b\xed\x5f\xa0\x10\x67\xd3\x46\x2a\x7d\xfb\x59\xa0\x19\xeb\xea\x13\xd0\x1d\xbf\xab\xdb\xb5\x26\x0e\x02\xad\x92\x98\xf6\x82\xd3\xa1\x76\x37\xe3\x3f\xf6\xed\xe6\x39\x0c\xbe\x3b\x64\x32\x94\x71\xe9\xe3\xe9\xa8\xd2\x30\x7b\x57\x2a\xa2\x12\x6b\x67\x54\x74\x8d\x7e\x12\xd6\x60\xaf\xcf\x91\x84\xea\x1d\xc2\x9e\x4c\x34\xef\x0e\xe8\x5c\xcf\x0f\x20\x4d\xe2\x24\x8e\xfd\x51\xdb\x3a\xa2\xcd\xf8\xe7\x01\x64\xaf\x4b\x60\x1c\x06\xe0\x46\x93\x14\x1d\x72\x9b\x20\x34\xcb\x6d\x1d\x11\x2c\x8c\x4c\x83\x2c\x80\x16\x62\x4b\x5a\x7e\xa0\xf5\xa5\x87\x2e\x80\xda\x39\x05\xf8\x4a\x2e\x57\x52\x6c\x03\x70\x80\xd0\xd1\x85\xdb\x46\xa5\x33\xd2\xd3\xbb\x6d\x59\x6c\xef\x4a\xef\xa5\xb2\xf5\xaf\xb6\xdf\x01\xab\xc6\xb1\xf8\x3b\xb0\xd4\x37\xe1\xa5\x8e\xf4\x57\x69\x39\x8c\x87\x19\x2f\x64\x74\xf4\xd6\x65\x16\xdc\x71\x2f\xaf\x5b\x8b\x30\xef\xee\x6d\x6c\x7d\xfb\xb7\x7b\x39\x77\x2e\xea\xd3\x7a\x6d\x67\xe6\x32\x6b\x27\xfc\x1a\x00\x6c\x0b\x40\xf7\x8b\x8f\x78\x69\xe8\xaa\xf7\xe4\x0e\xb7\xf6\x56\xf1\x4b\xcb\x3c\xcd\xce\xc9\x75\x85\xbd\xea\x4a\x0b\xde\x29\xbc\x55\x97\x56\x6f\xab\xdc\xed\x3b\xdc\x16\x7d\xb8\x5f\x5c\x35\xae\xbf\xda\x7e\x6a\x38\xf5\x93\x0f\x27\x98\x45\x11\xa9\xf4\x59\xd1\xb6\x51\x23\x25\x56\xa0\x6e\x21\x4c\x0d\x95\xb3\x76\xa2\xc4\x0f\x27\x74\xeb\xe0\xeb\x19\x9d\x02\x8a\xc9\x7e\x47\xd4\xb2\xbf\xd1\xdf\xbf\xc5\x4c\x8e\x37\xb4\xc8\x92\x37\x68\x17\xf0\xc6\xc1\x5b\xb2\xa4\x8f\x39\xa7\xdd\x84\xa9\x55\xcf\xc0\x5c\xd4\x30\x4c\xec\x73\xa6\xfc\x87\x70\xb7\x6e\xae\xd5\x46\x16\xa4\xfd\x19\x8c\x94\x3a\xdc\xef\xed\xa6\x14\xe7\xb0\xd7\x3d\xd3\xf4\xf6\x55\x6d\x68\xbf\x0b\xdb\x57\x35\x89\x96\xc8\xf1\x12\x92\xcc\x31\xd6\xa5\x6e\xb2\x7c\x1e\xe3\xce\xf3\x3c\x03\x26\x22\x8b\xa3\x6a\x66\x3d\x03\x6b\x95\x04\x4b\xcc\x55\x91\x0d\x95\xac\x54\x91\x12\x93\xf9\x30\x4b\x8d\xce\x80\xb9\xb0\xaa\x26\x3b\xc0\xfd\x8a\xfe\x83\xda\x77\x70\xc8\x64\xea\xaf\x8f\x9e\x96\x32\xd0\xb5\xa8\xd0\xad\xba\x3c\x7f\xb5\x87\x96\x1c\x2f\x4f\xca\x35\xb9\xfe\x30\x20\x0c\xca\xac\x95\x86\x4c\x03\x69\xb6\x0d\x61\x6d\x4c\xb5\x0b\xda\xdf\xc6\xfb\xf4\xf5\x70\xa0\xfb\x68\x65\x05\x7e\x27\xb2\xe4\xe7\x97\x39\xfc\xb6\x0c\xc5\xb3\xe5\x62\x55\xdf\xa4\x2f\xd1\x22\x8c\x35\x4c\x0e\x91\x88\xf5\xd8\x07\xb3\xe6\xaa\x97\xfe\x56\x98\xc1\xd5\x4e\x28\xb1\x3a\x2b\x1e\xff\x8b\x18\xec\xc7\xc6\x4f\x9d\xa5\xd5\xe4\x87\x2a\x68\x79\x68\xa2\x92\xf1\xfe\xad\xa9\xd3\x29\x32\xbc\x52\x59\x47\xa5\x98\x6b\xbb\x5b\x13\xec\xc8\x5a\xf8\x04\xcf\xd6\xa0\xd4\x13\xe9\x3e\x6d\xbb\x12\x65\x1e\xd0\x75\x3b\x9b\xaf\xce\x17\x23\x76\xae\x53\x53\x94\x53\x5f\xd6\x6e\x7d\x2c\xc6\x8d\x06\x8d\x46\x3d\xa4\x96\x24\x86\x04\x87\x16\x8f\xa7\xf0\xd5\x8e\x65\x7b\x6a\xe1\x30\x55\x89\xa6\x52\xcd\xb5\x7b\x39\x0d\xa9\x83\x58\x7e\x14\x32\x5b\x50\x09\x4d\x81\xad\x6b\xf8\xaf\x25\x71\x60\xd6\x83\xe3\xed\x10\x5d\x69\x0d\x9f\x47\xec\x4e\xb5\x88\x58\xc7\xf8\x12\x1b\x0f\xcc\x74\xd4\x21\x14\xda\x77\x86\x25\x92\x54\x49\x02\xf6\x24\xb9\x7c\x41\xe9\x6b\x59\x62\x1a\xcd\x5f\x79\xf9\x65\xbb\xe3\xad\x09\x56\xed\xd1\x67\xdc\x6f\xe3\xcd\xd3\x4c\xf6\xac\xbe\x49\x5c\xde\x45\x56\x28\x9b\x34\x2e\x73\xcb\x13\xa9\x08\x37\x9e\x6c\xac\x61\x76\xbf\x20\x80\x5a\x4e\xd7\x3c\xda\x5c\xe3\x05\xed\x6a\x2f\xcf\xf0\x0e\x37\x04\xed\xe0\x5b\x87\xeb\xe0\x5d\x2c\x2b\xa6\x02\x7e\x36\x68\x7a\x54\x17\xce\x7a\xe2\x46\x23\x94\x95\x5b\x96\xad\x97\x55\x38\xce\x06\xa6\x29\x1e\x77\xc4\xcc\x5b\x58\x5c\x79\xf5\x75\xd2\x00\x7d\x18\x09\x96\xe0\xb5\xf5\x50\x8f\x01\x26\x05\x38\x31\x93\x01\x46\x5f\xa0\x84\x93\xb1\xe3\xcd\xa0\x62\x35\x9b\x01\x66\x71\xf9\xf9\xfc\x5b\xa6\x06\x41\xe6\xf8\x14\x57\x3a\x29\x66\xae\x36\xbf\x3e\x15\x31\xf7\xcc\x40\xb8\x76\xa9\xec\x3a\xcd\xdb\x99\x69\x62\x33\x50\x1e\x31\x0b\x87\xad\x44\xa0\x53\xd0\xbf\xd5\x3b\xc5\x94\xd2\x18\xe9\xb1\x1d\x8a\x72\x1a\xaf\x36\x2c\xd1\xba\x0e\xde\x2c\xc4\x6c\x88\x5e\xa6\xfa\xe6\x91\xc5\x84\xaa\x07\xeb\x62\x73\xd6\x0c\x9c\x38\x88\x7d\x1a\x06\x1b\xe8\x88\x0b\xae\x8e\xc8\x38\x08\xc6\x23\x3f\x88\x02\x37\x98\x04\xe0\x39\xe3\x51\x10\x05\x69\xe8\x19\xbe\x55\x8b\x5c\xfb\xf0\x8a\x3d\xdf\xd4\xd7\x85\xd9\xe8\x58\x70\xfc\xf1\x38\xa0\xa1\x9f\xb8\x0e\xf8\x51\x9a\x82\x97\x26\xe8\x76\x74\xd2\x64\xc2\x46\x01\x65\x8e\x3b\x8a\x52\x27\x04\x2f\x18\xb9\x21\xb8\x6e\x18\x33\x17\x12\x98\xb0\xc9\x28\x8a\x1b\xf7\x6b\xb6\x0d\xc7\x67\x11\xc8\x36\xcc\xc4\x9d\x06\xe2\xb3\x0c\xb4\x6d\x0e\x3e\x07\x23\x6e\x6d\x09\xa2\xac\x72\x43\xb1\x15\xee\x5c\xc7\xa9\x78\xbd\x9c\xf5\x35\x98\x7a\xcd\x99\xf9\x09\x8d\x4d\x87\x90\xe3\xaf\xa5\x24\x7c\x27\x9f\xfb\xc8\xe7\x91\xd2\x7d\xab\x77\xb9\x6e\x4a\x23\x6f\x34\x2b\x91\x50\x08\xd4\xa6\x2d\x2f\x7b\xfb\x6c\x2d\xa9\xd2\x90\x9e\x1c\xe1\x4c\x56\xf4\xcd\x49\xd6\xdd\x3e\x09\xc1\x11\x8e\x81\xd6\x28\xf6\xd2\x57\x0a\x25\x14\x09\x3c\x39\x8e\xba\xca\xf2\xe9\x1e\xca\x32\x63\x70\x48\x5c\xd0\x1e\x7f\xa7\xc5\x0e\xc9\x2b\xbf\x3c\x37\x3d\x0f\x74\xe2\xb1\xba\xca\xb1\x1a\x97\xc4\x90\x62\x6d\x82\x0a\xf1\x95\x13\x4d\x17\x58\xc5\x2a\x5f\x4a\x61\x6d\x46\xe2\x10\xf2\x4e\x5b\x95\x54\xb2\x3e\x1d\x01\x50\x54\x83\xa8\x88\x9e\x2f\xb0\x94\xaa\xa4\x82\x18\x3e\x15\xcd\x74\x30\x25\x30\x09\x95\xec\x32\xf5\x7b\x6d\x92\xb5\x8f\x12\x5d\x90\x67\xc5\xf9\x1c\x20\x83\x1c\x26\x87\xd4\xb7\xc1\x90\x8c\x91\xb1\xd3\x64\x3b\x15\x99\xd9\x8e\x27\xea\x6f\x12\x8e\xd3\x22\x9e\x1a\xb4\x41\x8f\xd1\xdf\x3e\xcd\xd8\x33\x26\xe8\x0a\x23\xcf\xf3\x62\xa0\x2c\x76\xfc\xc8\x73\xfc\x18\x3c\x17\xd8\x38\x81\x30\x99\xc4\x6e\x9c\xa6\x81\xe3\xf5\xbb\x8e\x2a\x69\xf1\xd2\xea\x04\x19\x87\x99\xfa\x17\x8d\xdd\x84\xa6\x7e\x52\xb7\x6f\x66\xcc\xb2\x1b\xbc\x97\xdb\x1c\x96\x9e\xac\x75\x4c\xca\x55\x21\x33\x8c\x8c\x7f\x94\xb0\x2b\x43\x9a\x4a\x63\xe6\xe0\x86\x39\x8e\x4a\x64\xe6\x39\x98\xcc\x2c\xf5\x6b\x50\x8d\xdb\xf3\x80\xd1\x9b\xbd\xee\x44\x9c\x83\xd3\xd1\x1d\xd4\x9b\xc9\x33\x75\x2c\x05\x31\xcd\x30\x48\x10\x49\x83\xc2\xf7\xa3\xce\xed\x13\x30\xb7\xbe\x7d\x7e\x1a\x27\xa7\x6f\xfd\xaf\xcf\xf8\xa1\xf1\xa6\x88\xd3\xd6\x0d\xb6\xa5\x97\x0d\xc9\x65\xaf\xd4\x52\x75\x67\x63\xbd\xff\x45\x55\x47\xd2\xa9\x83\xc5\x3e\xd4\xe6\x69\x2a\xea\x12\x3d\xfb\x38\x5e\x85\x11\xce\xae\x7d\x6d\x73\x06\xdd\x33\x46\x57\xda\x7a\x87\x25\x24\xbc\x64\xad\xe0\x88\xfc\xd0\xab\xdd\xd5\xe8\xee\x81\xc3\xab\x9e\x91\x59\xe8\x51\x15\x87\xd2\x4a\x53\x6f\x6f\xdb\x25\x15\xca\x35\x23\xa0\x91\xb0\x1d\x8d\xf5\x8f\x7c\x45\x0a\x40\x57\x9c\x5a\x5b\x60\x95\xcd\x7f\x49\x67\xe8\x0c\x51\x25\xe2\xab\x7e\xa6\xd3\x3a\x1b\xec\x3f\xab\xbf\x08\xf9\x41\x57\x60\x10\x3f\x5c\xb5\x1e\xe3\x0b\xb5\x60\x3f\x5c\x11\xa7\xce\xf8\x8b\xbf\x3f\xa8\xa9\xfc\x80\x97\x8c\x2d\xed\xd2\xbf\xff\xdd\xdb\xfe\xab\x39\x2c\xf2\x5c\x1a\xf3\x7b\x74\xe1\xa4\x55\xad\x2c\x84\xb6\xda\x1c\x41\x1c\x53\x6f\x02\x33\xcd\xe2\x1b\x75\xe1\x29\x13\xc4\x75\x6a\x5e\xaa\xd6\xc4\xc0\x6d\x0b\xea\x9b\x15\x61\xbc\xe8\x4b\xbd\x2e\xaa\xc0\xe5\x02\x3b\x5b\xd2\x19\x06\xe4\x36\x51\xf1\xb6\x4e\xfc\xdd\x8d\x88\x78\x1f\x67\x1b\x11\xb6\xcf\x78\xb1\x5a\x34\x3f\x43\x6e\xbb\x79\xe9\x13\x9f\x21\xed\xed\x75\xe1\xcf\xe6\xc7\x7b\x50\x88\x41\x9a\x15\x2a\xd1\x07\x60\xee\x02\x15\x72\x69\xf2\x15\xe3\x2c\xa7\x92\x37\x12\xdb\xe3\xbf\xa9\xea\x7c\x6a\xfc\x7b\xcd\x5c\x1c\x98\xcf\x38\x5b\x40\xfb\x55\x95\x0a\x61\x60\x0b\x7f\x22\x92\x9a\x4e\xda\x3d\x57\xff\xc1\xe1\x0f\x39\x2f\x3b\x35\x92\xce\x63\xbc\xf7\x4a\xeb\x29\x9d\x23\x57\xb6\x09\xc7\x76\xae\x71\x73\x7d\x55\x2e\x77\x9c\xbe\xae\xeb\x46\xb2\x42\x1f\xa8\x4e\xc4\x6e\x9d\x27\xd5\x72\xfb\x34\xe1\x86\xfd\x70\x45\x7e\x50\xab\xf9\xc3\xc6\x89\xc2\x55\x54\x07\x6a\xe3\xb9\xe4\x3f\x6c\x48\x14\x4f\x9f\x32\x7b\xb6\x78\x63\x1e\xd8\xbf\xd9\x64\x17\x13\x2a\x57\x7f\x3b\x8d\x53\x65\x0e\x12\x16\x6e\x61\xda\x96\x56\x95\x5d\x52\xbd\x74\x60\x80\x3e\x4b\x77\xd9\x02\x9e\x3c\x4f\xe7\x43\x14\x77\xec\x3b\xbe\x1b\x44\x8e\x73\x7e\x34\x19\xfb\xce\xc8\xf1\xdd\xc9\xe4\x58\x4c\xe1\xe9\xe6\x21\x6a\x21\x8f\xc9\xe7\xae\x42\xca\x55\x59\x36\x91\xdd\xc3\x90\x5c\x63\xd1\xb4\x84\x2f\xe2\xac\xb0\x79\x74\xa7\x6a\xad\xeb\xed\x7c\xf3\x77\xde\x78\x49\x0b\x36\x25\xb8\xb8\x54\xf2\xf2\xed\xe0\xb5\xa0\x64\xf3\x9b\x1f\xa4\x45\x87\xed\x11\x6d\xa7\xd5\x0e\x76\x76\xbe\xb9\x09\xc7\x61\xbd\x9a\x8d\x20\x38\x14\x72\x28\xc9\x15\x9a\x37\x2a\xf0\x99\xab\x49\x55\x12\x76\x1d\xc1\xc2\xe8\xe3\x31\x47\xa1\xbe\x5a\xf5\xde\x94\x93\xdd\x87\xfc\x46\x2b\xad\x1f\x10\xbc\xfe\xbc\x1d\x6b\xb0\x83\xc9\xd4\xaf\x36\xcd\x49\x3b\x4c\x4a\x7b\x18\x56\x0b\xa3\x0d\x5c\x55\x6d\xeb\x56\xcd\x78\x35\x16\xbe\x3a\xb0\x58\xfc\x29\xb9\xc3\x1a\xf7\x17\x6d\xd5\x5a\x0d\x42\xe3\xfa\xc7\xb3\x33\x7e\xd5\x31\x65\x07\x0e\x44\xe3\xec\x58\x1d\xe2\xbc\xa5\xb7\xf1\xfa\xcf\x59\xcb\x6f\x37\xa9\x5c\xbb\xa9\x5a\xbf\xf6\xf4\xeb\xfa\x7c\xd6\x52\xdd\x7a\xa5\xfc\x3c\x5b\x08\x67\x2b\xf9\xaa\x3b\x49\x1b\xef\xec\x30\x06\x91\xb6\xde\xaa\xeb\x58\x5b\xe9\xee\x37\xfa\x95\xfc\x25\x7a\xdd\x54\xf6\x9a\x1d\x1b\x4b\xf1\xee\x8e\xdb\x56\x6f\x75\x1b\xd1\xf9\xed\xcf\xb8\x82\xc3\x7d\x25\x70\x78\xaf\x04\x0e\xff\x95\xc0\x31\xfa\xad\xe1\xd8\x41\xb5\xaa\xa2\xe8\xb5\xd4\x82\xb1\x24\x8a\x30\x0c\xc9\x3b\x4c\x08\xa3\xcd\x9d\x68\xdf\xdc\x2d\x92\x0c\x2d\xeb\x54\xc6\x51\xc5\x6e\xb3\x59\xc1\xcb\x23\xf4\x51\x73\x9c\x51\x30\xd9\x6f\xe4\x18\x8d\x83\x8f\xb6\xdc\x68\x4b\x7a\xf9\x41\xad\xb4\xa3\x7b\x60\x2c\xf5\xc6\x1e\x65\x6e\x0c\x5e\x12\x4d\xe2\x60\x92\x78\xb1\x13\x44\x69\xe2\x87\x11\xa3\x74\x32\xf6\x62\x1a\xa6\x6e\xe0\x27\x23\xea\xba\x81\x17\xa5\xe3\x31\x1d\xb1\x74\xec\xf9\xb1\x0f\xe9\x0f\x4f\x08\x1e\xda\x98\x20\x2c\x05\xb7\x4c\x05\xcb\x8e\x39\x6b\x18\x4f\xd8\x28\x1c\xd3\x18\x82\xc9\x38\x09\xd3\x20\xa4\x11\xf5\x7c\xcf\x4d\x7d\x9f\x46\xe3\x20\x76\xe2\x51\x12\xba\x6c\x5a\xdd\xdc\xa8\x89\x3f\xfc\xe7\x8a\xe6\x82\x4c\x9f\x3f\x85\x86\x56\x58\xfd\x31\x35\xcb\xac\x47\x56\x63\x0a\x42\x73\xc1\x4d\x55\x21\xed\xb8\x12\x03\xc3\x2b\x37\xb9\xbe\xce\xd9\x23\x86\xe4\x9d\x24\x0b\x2e\x24\x1a\x73\xcd\x33\x25\x56\x61\x22\xb4\xd6\xf5\x58\xeb\x67\x32\x32\x57\x85\x6e\x02\xe4\xb0\xb7\x83\x3f\x19\x10\x8f\x43\x84\x4d\x72\x4c\xfa\xcf\x5f\xc0\xfe\x26\x6d\xdd\x67\x7f\x3b\xcd\xc8\x5e\xcb\x93\x5a\xa7\xda\x27\x4d\x96\x4d\x5d\xeb\x29\x5b\x5c\xc3\xdc\xd1\x98\xc6\xa6\xc6\x76\x58\x2f\x95\xa2\x57\xf7\x94\xac\x4a\x71\x90\xa7\x77\x8f\xb4\xa4\xfb\xb0\x98\xa5\xb2\xc8\x60\x06\x62\xf3\xff\x65\x09\xf7\x19\x5f\x69\xb3\xd6\x80\x48\x8a\x91\x2b\x28\x64\x90\xe9\xfa\x42\xce\x79\x09\x42\x5e\x14\xb0\x96\xd3\xaa\x56\x0d\x99\x03\x65\x50\xd6\x68\x8f\xbf\x9f\xf0\xee\x14\xd6\xde\x31\x35\x45\x33\x49\xde\x18\xdf\x4f\xa6\x2e\x53\x65\x05\x99\x22\x94\x53\xc2\x4b\x06\xe5\x5b\xc4\x5f\x53\xad\x08\x58\x97\x20\x85\x58\x30\x4a\x42\x27\x88\xb6\x1c\x15\xc6\x38\x75\xdc\xf2\x1a\xf3\x68\x7f\x8b\x28\x7f\xee\xb2\x88\x6e\xb2\x81\x0e\x16\xb0\x6f\xc8\x96\xee\xd2\x00\xbc\xdc\xb8\x37\xbd\x67\xdf\xd4\x32\xe1\xb6\x99\xda\xf7\x95\xd9\x48\x09\xac\x53\x2a\x92\xe9\xd3\x78\xd1\x65\x40\xa3\x22\xd9\x78\xc2\x60\xe3\x51\xeb\x26\xf8\x21\x2a\xd8\x81\xda\xc9\xcb\xd4\x11\x3d\x42\x73\x69\x02\x70\x28\xfb\xe8\x1f\x7f\xef\xfd\x79\xc3\x1c\x73\x8d\xbd\x39\xd2\xe1\x4e\xbb\xd6\xfe\x7e\x27\x89\xdf\x49\xe2\x57\x20\x89\x9b\xe4\xe4\xdb\xa1\x8a\x26\x91\x02\xc6\x4d\x01\x7b\x25\xd4\xf0\xff\xb3\xf7\x6c\xbd\x91\xdb\x56\xbf\xfb\x57\x08\x79\x99\x04\xb0\xc7\x14\x45\xdd\xfc\xf6\x6d\x76\x3f\xd4\x48\x8b\x6c\xb3\x5b\xa4\x40\x51\x74\x29\x92\xf2\xa8\x1e\x4b\x13\x49\x63\x8f\xd1\xf4\xbf\x17\x87\xa2\x24\x52\xe2\x68\x34\x97\x4d\x77\xd1\xd8\x8b\x05\xac\xd1\x90\xe7\xf0\x5c\x48\x9e\xeb\x17\xa0\x0d\x9b\xb6\xe3\x9f\x57\x47\xb5\xab\xfe\x3f\xae\xa3\x1a\x1d\x45\xeb\x5a\x3c\x6d\xea\xcf\xa2\xa7\xd4\xd8\xbf\xeb\x2a\xa9\xab\x86\xc2\xfe\x75\xe8\xaa\xfe\x96\xf3\xa1\xa6\x75\x65\xca\xcc\x20\x8d\x6c\x3a\x81\x4c\xbb\x2a\x2d\x26\x64\xee\xa1\x2c\xb6\x9b\x37\xaf\x77\xa7\x61\x61\x73\xe4\xaa\x4b\xa9\x8d\x9f\xcc\xd7\x93\x2d\x7b\x14\xf5\x87\x8b\x96\x00\x55\x8d\x14\x5a\x67\x53\x05\x4c\x0f\x65\x87\x9b\xb9\xae\xbb\xc6\xa1\x70\x9d\x91\xa8\x37\x45\xaf\x24\x60\xd7\xd0\xd7\x5c\xde\xd5\xdd\x20\x0c\x43\xec\x06\x36\x1c\xa2\x80\x20\xf3\xf0\x75\x2e\xa5\xda\x71\xfe\x1b\xc4\x6a\x8e\xc3\x83\x87\xdd\xe1\xd5\x86\xff\xe0\x1b\x5f\x3b\x11\xff\x58\x3c\x48\xfa\xdd\x4d\x2c\xfb\x68\x5f\x32\xa0\xed\xb3\x66\xe5\x7b\x1d\x94\x5a\x15\x92\x21\x90\xda\x50\xb6\xf9\x6c\xde\xe0\xa9\x55\x9d\x5c\x59\x03\x6f\xdd\x27\x39\x74\x0a\x5f\x66\x86\x28\xf0\xe2\x91\x7d\xea\x30\xbf\x8e\x56\xb4\xf3\xb3\x35\xfc\x06\xee\xb6\x8e\x2d\xf7\xad\xed\x84\xe6\x39\xea\xd8\xd2\x7e\xdb\x68\x61\x3b\xb5\x3c\x33\xfc\xe6\x18\x9d\x59\x44\x4c\xaf\x12\x05\x1d\xf6\xa1\x98\x8d\x1c\xa8\x5f\x8d\x54\x6b\x51\x55\xc9\xd2\x71\x36\x50\x26\x2a\x8d\xbd\x17\x4a\x9b\xdd\x5d\xed\xe7\x4e\xe9\x6d\x3a\x0c\x7c\x3f\x1f\x9c\x63\x6e\x9f\xdd\x25\x5a\xa2\x9b\x20\x88\x50\x12\x47\x37\x5c\x3c\xdf\xae\xb3\x7c\xbb\xbb\x7d\x28\xdc\xa5\x8b\x96\x7a\x04\x25\xb4\x7b\x9e\xdd\x38\x4b\xc7\x0b\x50\x89\xc2\xc4\xa3\x84\x13\xc6\x53\x97\x31\x1f\x73\x3f\x48\xe2\x10\x91\x94\x30\x37\x4a\x11\x46\xc2\x4d\x48\xc4\x93\x24\x25\x14\x7b\xdc\x15\x82\xa4\x6e\x4a\xfd\x34\x8d\xc9\xe2\xc4\x46\x15\x1d\x0c\x41\x44\xe2\xb0\xfb\x60\x23\x44\x79\x24\x0e\x3e\x12\x2e\xc6\xd4\x47\xbe\x10\xd0\x51\x87\x78\x9e\x8b\x82\x88\xb2\x94\x47\x7e\x28\xbc\x90\x72\x3f\x4a\x49\xe0\x51\x94\xd2\x24\xa6\x34\x4d\x31\x73\x05\x49\xb0\xc0\x1c\x63\x2a\x42\x97\x33\x97\xa4\x9c\x42\xbf\x18\xca\x43\x92\x70\x2f\x0d\x90\x1f\x93\x80\x10\x4a\x3d\x9f\xf9\x51\x94\xc6\x8c\x06\x89\xf0\x3c\xe2\x0a\xcc\x84\x1b\x71\xce\x88\xeb\x79\x58\x6b\x6c\x90\x0b\x99\x49\x7e\x14\xf4\x2e\x8e\x96\xee\xd2\x8b\x97\x2e\x46\x77\xae\x8b\x3d\x2d\x27\x29\xcb\x93\x62\x9b\x9f\x93\x34\xc3\xb7\xf3\xa3\xfd\xbb\x21\x70\xa4\x58\xbb\x28\xd6\xc0\xda\xdb\x49\xde\x96\x64\x3f\x6a\xfc\xbe\x8f\x50\x13\x1f\x0f\x4d\xce\x8f\x1a\xa0\xd7\xa4\x79\x91\xbf\x3b\x6d\x0c\xf7\xac\xf0\x4c\x3d\x54\x45\x46\x2e\xbe\x17\xa5\x8a\xb5\x3e\x6e\xa4\x7e\xab\x6d\x02\x0a\xaa\xf1\xd7\x67\x1c\xea\xf7\x04\x13\xd8\x09\xa6\x4f\x37\x7c\xba\x97\x61\x2f\xb1\x37\xec\xe1\x97\xe9\xa5\xda\xcb\x3b\x53\x1c\x74\xd4\x90\xb8\x55\xe4\xb2\x95\xff\x05\x3a\x29\x7c\x1e\x03\xc7\x49\x65\x69\x8e\x27\xd2\xf9\x65\x69\x8e\xc8\xf0\xd1\x41\x55\xf7\x65\x44\x69\x92\x30\xc6\xb9\x35\x13\xe2\xea\x30\x75\xf7\x9e\xb9\xac\xa5\xbf\x1e\x2e\x9f\x6e\x7d\xa9\xb4\xba\x3d\xa9\x94\xa7\x14\xd4\x72\x17\x17\xac\xe8\x65\x17\xb9\x83\x1b\x93\x11\x30\x73\x66\xc6\x7f\x5b\xa4\xc7\x48\xf5\xa7\xb9\x2c\xc0\x93\xc8\xd2\x43\xd5\x16\x6e\xac\xaf\xa2\x9e\x93\xfa\xdf\xed\x76\x3f\xaf\x5e\xbf\x50\xe9\x3f\x71\xd1\xcd\xd3\x40\x79\x52\xfa\x2b\x18\xdf\x64\x41\xe5\x1e\x06\xdb\x54\x8b\x74\x0b\x1d\xad\xda\x7b\x9d\xee\xe0\x16\xcf\xd9\x49\x75\xa4\x5e\x56\xa2\x5e\x89\xb2\x8b\xb0\xa3\x55\x3b\x54\x5f\x2a\x6c\x53\x14\x1a\x6b\x36\x13\xfd\x5f\x7d\x96\xe0\x19\x30\x98\x1d\x4f\x9d\x97\x95\xc8\x2d\xf0\x5c\x8f\xaa\x4f\xab\x0f\xba\x61\x4b\xb1\x59\x53\x26\xf8\x2c\x53\xc4\xfe\x70\xc6\x76\x98\xa6\x53\x44\x91\x8b\xf1\xcc\xdd\x2b\x50\x2f\x01\xda\xbb\xad\xd7\x82\xf7\x3c\xfe\x06\xe8\xb3\xce\xaa\x7a\x8a\xd3\x05\x54\x5a\x11\xd5\x18\xd4\xf1\x32\x1a\xb0\xaa\xe4\x48\x65\x85\x10\x5a\x5d\x73\x1b\xcb\xb8\x9a\x22\x66\x14\x62\x16\x4f\x9f\xb0\x9b\xc8\x81\x52\x9b\x2d\x87\xac\x0b\x46\xd7\xcd\xd8\x6d\xd4\x89\x8c\xbf\x05\xe2\x3b\x55\xb1\x2d\x99\x68\x82\x1b\x53\x51\xb3\xd5\x7e\x8d\xe1\xba\xbd\xb2\x97\x51\x27\xfa\x02\x9e\x03\xac\x0a\x5d\xe9\xc6\xb4\x4e\xde\x3d\x7c\x16\x25\x34\x65\x3a\x5d\x92\x5a\x34\x01\xfc\x26\xec\xaa\x1d\xb2\x09\x14\x82\x2a\x7b\x14\xc4\xb8\x3a\xa8\xc2\xd5\xea\xfd\x56\xe7\xd5\x6d\xb9\xf7\xd0\x38\x12\x9f\x01\xd8\x8b\x55\x5d\x6f\xaa\xbb\xdb\x5b\xf5\x64\x59\x94\x0f\xb7\x49\x2b\x06\xcb\x7a\x37\xa8\x17\x66\x65\xff\x69\x32\x4f\x30\xb6\xba\x24\xd0\xaa\xfe\xcb\x86\xd3\x81\x1a\x9c\x33\xea\x5e\x3d\x75\x58\x5b\x29\xbd\x21\xdd\x28\x5b\x39\xfb\xb5\x83\x40\x47\x34\xa9\xc8\xcd\x23\x3e\x81\x47\xd0\x9e\xca\x06\xef\xc0\x88\xa3\x72\x0c\x33\x68\x62\x00\x2b\x0b\x4a\x19\x30\x4a\xf6\x1c\x6b\xb4\x94\x66\x6b\xc1\xe7\xd4\x5f\xfb\xf8\xd7\xfb\xb7\x53\x7a\xed\xe0\x0e\xde\x8e\xd9\xbd\x95\xf1\x0b\x76\xed\xe8\xff\xfb\x11\x0a\x19\x88\x5a\x4c\x01\x5b\x0c\xde\x99\x2d\xed\xa6\x3f\x26\xcb\x79\xc6\x64\x33\x67\x7d\x3f\x95\xfc\x2f\xf3\xe6\x69\x96\x43\x35\x0f\xb9\xa1\x40\xbe\xb5\x93\x08\x26\xdb\x5d\x95\x34\x67\x2b\x65\x7d\x6d\xad\xf7\xac\x75\x49\x4d\x01\x3e\xd7\xdc\x65\x31\xb8\x13\x68\x89\x31\x78\x96\x64\x0f\x25\xed\x53\x02\xe0\xf7\xc6\x2c\x00\x04\xbf\x37\x8e\x78\x7e\xe2\x99\xae\xb8\xe0\x61\x5e\x14\x7a\x17\x5e\x78\x54\x6c\xe4\x36\x35\x78\x0a\x4c\x37\x68\x7f\x09\x2f\xd7\xa5\x6d\xf6\x6d\x3e\x7c\x3a\x41\x80\xae\xa1\x89\x5c\xbe\xa5\xf3\x4e\x1e\xa8\xe4\x53\x2d\x19\x44\x39\xc9\xe0\x30\xb6\x65\x35\x44\x50\x3c\xc0\xd1\xa7\xf9\xce\x95\x85\xeb\xbf\xf9\xe6\x78\x9f\xf4\x04\x94\xc0\x14\xdb\x5c\x6e\x2f\xce\x86\xd6\x4d\x0f\x56\xe9\xeb\xee\x4b\x3a\x31\xd3\xe9\xe9\x38\xdf\x37\x7d\xa0\xd6\xaf\xd7\xd2\x76\xaa\x0a\x05\x40\xc6\x40\xd7\xee\x74\xe9\xfc\x7f\xa3\xc0\x8c\x2f\x7e\x52\x05\x35\x6f\xbf\xad\x77\xb2\xa5\xc9\xaf\xf5\xee\x9e\x7f\x77\xab\x35\x39\xff\x64\x43\xba\x89\x91\xe4\x34\x49\x08\x0f\x52\x44\xe1\x52\x1b\x52\x1e\x32\x8e\x04\x0a\xa9\x9b\x62\x94\xf8\x24\xe0\x09\x82\x12\xd2\x51\x10\x73\x9f\xb1\x04\x71\x8e\xa9\x1b\x88\xd0\x8f\xfd\xe4\x16\xdd\xb6\x67\xfe\x8f\x80\x12\xe4\x29\x9b\x3c\x7d\x94\x2b\xca\x28\xe7\xb2\x38\x5f\x2a\x66\x33\xd2\xb5\x53\x09\xe1\x7c\xd2\x85\xf2\xd3\xe5\x98\x0b\xe4\xeb\x1b\x55\x87\xbc\x49\x65\x97\x41\x02\x87\x85\x5f\x9d\x6d\xce\xc3\x74\xdc\x09\xc3\x06\xe4\x02\xed\x28\x09\x70\x88\xbc\x40\x60\x14\xfb\x22\x09\x5d\x86\x3d\xe2\x22\x9f\x70\x4a\x03\xcf\x0f\x43\x86\x02\x4c\x62\xad\x6b\xd2\xa3\x78\xfd\x50\xd3\x72\x8e\xb4\xe8\x13\xa9\x7d\xf0\xe4\xdf\x1e\x80\x27\xba\x33\xd3\xe2\x7b\x08\x1a\x2f\x9e\x0d\x02\x17\x1d\x2f\xec\x03\xf0\x05\x17\x69\x42\x08\x74\x3f\x4e\x63\x16\xe2\x94\xe1\x24\x26\x41\x1c\x21\x91\xfa\x2e\x8f\x38\x46\x51\x92\x50\x4a\xb8\x97\x72\x96\x22\xe6\x87\x9c\x44\x24\xa4\x8c\x62\xa1\x09\x8d\xce\x0e\x53\x8c\x90\x8b\x5d\xfd\x83\x78\x3d\x02\x50\xed\x91\x63\xda\x1c\xe6\x17\x61\xb0\x8e\xb5\x40\x3b\xcf\x13\x04\x7b\x71\x84\x58\x9c\x78\x21\x47\x24\x4a\x38\xec\xce\x09\x27\x14\x53\x91\xc4\xbe\x4b\x82\x18\x63\x44\x7c\x82\x7c\xca\x18\xc3\x29\x09\x22\x8e\x44\x1a\xc3\x95\x7d\x61\x8e\xe8\x40\x61\x87\xe1\xa3\x4b\x14\x62\xd0\x4c\x35\x7a\xa5\x94\xcb\xcf\xc4\x94\x4c\xbc\x11\xb4\x9e\x24\xa3\xe4\xc9\xf1\xca\x4f\x5f\xa8\x3d\xbc\x4f\xd0\xf5\x8c\x71\xe7\xdb\x95\xc8\x1e\x56\xf5\x77\x16\x02\x3a\x1e\xf6\x3d\x4c\xe6\x1f\xdd\x74\x10\x0e\x74\x38\x54\x15\x9b\xbb\x26\xb7\xb6\xe9\xfb\x6e\x04\x2c\x8a\x92\x84\x04\x38\xa0\x31\x8e\x51\x18\xba\x91\x88\x70\x8a\xc1\xd7\x94\x42\x9b\x34\xe2\x7b\x34\x8c\x44\x14\xc6\xa1\x48\x22\x26\xa8\xe7\xc5\x5e\x82\x5d\xcd\x93\xb3\xa1\xb0\x4d\xde\xbf\xbd\x1c\x0a\xcd\x88\xc7\xf6\x24\x4d\x05\x47\x31\x77\x03\x3f\x49\x79\xea\x79\x8c\x21\x21\x38\x09\x05\x43\x41\x14\x7b\x11\x38\xc0\xc2\x24\x64\x2e\xa6\x44\xd0\x58\xaf\xe0\xdb\x5d\x2a\x8e\xe5\x84\xfd\xa6\x95\x06\x76\xf3\xca\x62\xc3\xc3\xf5\x3d\x0f\x07\x61\x8c\xd0\x17\xdb\xa1\x3d\x59\x17\xc5\xd3\x11\xc4\x5d\x89\xdd\x3e\x28\xcc\x8d\x50\x1d\xd5\x8b\x27\x15\x4d\xe5\xc8\x13\x48\x95\xd5\xed\x8d\x9d\xa6\xa9\x00\x0b\xd4\xb4\xa5\xe5\x7c\xbd\xf4\xfb\xcf\x57\xfe\xd3\x8b\xf2\xe3\xe5\x44\x66\xcc\xac\x7d\x20\x92\x6c\x3f\x98\x6e\x73\xd9\x1d\xb6\x39\x86\xea\x9c\x6c\x63\x53\xaf\x7d\xe2\x38\x03\xa7\xdc\x9f\x44\x55\xd1\xe9\xf3\xc6\xac\x0d\xe2\xf3\x58\xe7\x7f\x23\xdf\x5c\x65\x38\xe3\x8f\xbc\x59\x53\x6e\xb6\xef\x76\x9c\x1b\xcd\xab\x30\xfc\x60\x60\xbd\x86\x7f\x37\x4d\x91\x89\x3d\x4d\xc0\xcd\xe1\xfb\x81\x8f\x36\x5e\xb4\xbe\x9d\x6d\xfe\x98\x17\x2f\xf9\x75\x6f\x72\xcf\x0b\x2e\xda\x64\xf4\xea\x35\x67\x60\x76\x57\x55\x14\xea\x1d\x7c\xa0\xa0\x86\xc0\xa7\x29\x50\x0d\x33\xe6\x69\x3e\x91\xe6\x5b\xc0\xe5\x72\xce\xac\xc8\xc7\x56\xab\xe1\x1a\xb6\x76\xf9\x19\x87\x58\x63\xae\xe1\xb8\x43\x4f\x00\x95\x3e\x10\x70\x09\xec\xfa\x46\x0c\x95\xbc\x2a\x4a\xb6\x94\xc9\x98\xd2\x6b\xa8\xcd\x60\x13\xa0\xb1\x10\x4d\x2c\xc7\x84\x9b\xa2\x83\xcc\x68\xd6\xe2\xf4\xce\x08\xfb\x14\x63\xbe\x38\xa0\x6e\xe0\x9f\x6e\xd2\xaa\x77\x0e\x2f\x84\xe4\x8f\x15\x58\xb4\x92\x6d\xad\xe6\xac\x4c\xb8\xa4\xdb\x44\x5a\xde\xb3\x4a\x2e\x9e\x34\x5e\x5c\x8f\x5e\x72\x9e\x0c\x43\x76\x2b\x84\xd9\x7a\x0d\x25\xae\x5b\x16\x83\x30\xba\x42\xfa\xa9\x80\x41\xfb\xed\xb7\xee\x22\x09\x2c\x36\x85\x19\x21\xae\xbb\xc5\xd5\x9e\x65\x18\x71\xda\x6e\x43\x73\xde\x3a\x79\xee\xab\x8f\xe5\x36\x7f\x9c\x54\x95\xe6\x2b\x53\x34\x30\x26\x1e\x1b\x10\xa5\x13\xca\xa9\x57\x4e\x0d\x03\xaa\xec\x85\xf7\xdf\xff\x24\x7e\xd9\x8a\xaa\x9e\x82\xe1\x9f\x55\x91\x97\x1b\x36\x86\x61\xc4\x6a\x9d\xe0\x2e\xf0\x12\x2d\x26\xf5\xfd\x78\x1f\x33\xe0\x2f\x1b\xb0\x9c\x8c\xb7\xc4\x56\x7f\x57\x0e\x05\xb6\xc9\xd2\x8c\xc9\x40\x83\x03\x7d\x25\xfa\xe0\xa1\x27\x51\xaf\x0a\x7e\x14\x12\xa2\x5e\xfd\xe3\x41\xd4\x6f\xda\x5e\x6f\xed\x1b\xb2\x12\x5b\x35\x1e\xca\xee\x4f\x71\xfe\xf5\x6f\xdb\xe8\x7f\x3b\x66\x63\xb9\x76\x16\xd0\x5f\xae\xaa\x17\x7f\xd7\x28\xd7\xe4\x4c\x7c\x01\xa4\xb3\x2c\x77\x39\x32\x9c\x18\xf4\x6d\x68\x0a\xaf\x5c\xb7\x6d\x7a\xc0\x6f\xdd\xf8\x19\x98\x6c\x5a\x6f\xa5\x27\x18\xf8\xc3\x34\x75\xd3\x18\x79\x38\xa4\x14\xa5\x91\x46\x18\x31\x74\x74\xec\xd1\xda\xb6\xa5\xb2\x55\xd6\x9c\xc2\xd9\x00\xeb\xc6\x83\x72\x99\xc6\xa7\x4f\xe6\x39\xe8\xc0\xf2\x8f\x4b\xaf\x8f\x96\xac\x6f\x87\x2d\xdf\x6d\xaa\x3f\xa9\x72\xd9\x5d\x93\x45\x60\x59\x30\x08\x02\x97\xf4\x05\x03\x9b\x71\x55\xef\x9c\xfb\xfc\x3d\xad\x57\xed\x54\x60\x7f\x1c\x16\x51\xc9\x40\x73\xd1\x7a\x75\x65\x87\xc2\x6e\xef\x6b\xe3\xd6\x8d\x5d\xbb\x51\x91\x77\x57\x93\xd8\xb7\x07\x58\xd5\x20\xfe\x6a\xb0\xb6\x47\x55\xae\x95\x5f\xfe\x89\xbe\xdc\xe7\x7f\xde\x8a\xb2\xb3\xfb\x34\x58\x96\xf4\x45\xfd\x0d\x18\xfe\x02\x2f\xd8\x50\x6c\x75\x67\x29\xc0\xb7\xf8\x2c\x1c\xea\x94\xf4\x45\x6f\x1a\xbb\x1c\xe1\xac\x87\x6f\xd8\x91\x6e\x15\x76\x9b\x36\x96\x55\x59\x91\xdb\xc1\x54\x1f\xce\x81\x95\xd1\x1c\x76\x38\xc3\x54\x53\x94\xce\xfd\xdb\xa5\x8c\x34\xee\x75\xff\xb8\x0d\xcf\x72\x12\x5c\x45\xa3\x01\xb4\x63\xce\xb1\x00\xbb\x8f\x75\xfa\x33\x41\x6b\x0b\x81\xf3\x5f\x5b\x91\xb0\x28\x9d\x05\x80\xbc\xd0\xad\xe1\x8d\xd2\x53\x9e\xad\xf3\xf8\xac\xe3\x27\x98\x04\xc4\xc3\x71\xfe\x20\x28\xb7\x52\x00\xb2\x65\xe7\xac\x3e\x60\x90\xca\x9c\xb5\x06\xc4\xc3\x8b\x3e\x07\x5e\xdd\x76\xfb\x83\x78\x35\x57\x7d\x6a\x81\x41\xa9\x3e\x8a\xd7\x6f\x37\x45\x25\x4b\xd6\x7e\xa7\xea\x60\x83\xbc\x2a\x61\x6d\xed\xb3\x53\x8b\xd9\x10\xf6\x51\xbc\xce\x01\x76\x2c\xac\xed\x35\xf6\xc4\x1f\x57\x09\x71\x93\x30\xd4\xe9\x2c\x0b\x95\x94\x2a\x9a\x43\xa8\xb1\xd6\x52\x31\x26\x59\x73\xe0\x34\xca\xb7\x94\xa3\xc5\x39\x2c\xdd\x27\xad\x06\xf1\x03\xd1\x56\x2e\x31\xb0\xfe\x11\x52\x18\xad\x38\xcb\x94\xbd\x39\x18\xff\x7a\x75\x7c\x96\xdf\xc9\x08\x8f\xef\xb1\xc3\x1c\x40\x2d\x5b\x59\x5b\x1f\xda\x26\x05\x7e\xdc\xdd\xbf\x9d\xcf\xe7\xea\x02\xd3\xeb\xe3\x11\xfc\x23\x6e\xce\xf8\x7c\x6c\x3e\x87\xe5\x41\x05\x75\x35\x72\x69\xa5\xec\xa6\xa8\x8e\xa3\x2b\x75\x2a\x0a\x0d\x0b\x3a\x65\x0a\x0a\x13\xce\x54\x4f\x70\xa5\x02\xae\xae\xb6\x49\xf7\x4d\x43\x35\xdd\xbf\xb5\x6b\xa7\xf9\x5b\xc2\x3b\x75\x91\xb1\xa2\xd2\xdd\x72\xec\xf8\xd8\xd9\x6c\x0f\x96\xfa\x45\xa6\x4d\xe7\x55\x58\x64\x55\x37\xd3\x72\xfe\xd6\xab\x0c\x55\x76\x1a\x34\x9f\x5d\x14\xee\x42\x81\x2d\x1b\xd9\x82\x9e\x71\x32\x28\xfb\x69\x4e\x35\x03\xee\x9f\x07\x8d\xbd\xad\x08\x0c\xbb\x7f\x5f\x1c\x93\x9b\xb6\xeb\x1c\x55\x27\x4f\x69\x3c\x00\x45\x02\x85\xad\x9f\x3b\x42\x01\x08\xca\xf6\x32\x0b\xc5\xff\x0c\x00\xc9\x91\xe5\xad\x2e\x40\x01\x00")
 
 func thorYamlBytes() ([]byte, error) {
 	return bindataRead(
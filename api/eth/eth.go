@@ -0,0 +1,636 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package eth serves a subset of the Ethereum JSON-RPC 2.0 API on top of thor.
+//
+// Thor concepts are mapped onto eth ones as follows:
+//
+//   - block hash is the thor block ID, and totalDifficulty is the block's total score.
+//   - chain id is the chain tag, i.e. the last byte of the genesis block ID.
+//   - tx hash is the thor tx ID, and 'from' is the tx origin.
+//   - a thor tx may have multiple clauses. 'to', 'value' and 'input' of an eth tx object mirror
+//     the first clause, and all clauses are listed in the extra 'clauses' field.
+//   - gasPrice of a tx is the effective price, that is paid / gasUsed of its receipt.
+//   - receipt 'status' is 0 if the tx is reverted. 'contractAddress' is the address of the first contract
+//     created by the tx. 'gasPayer', 'paid' and 'reward' are appended as extra fields.
+//   - logs are events emitted by all clauses, and logIndex is the position of the event in the block.
+//     VET transfers are not events, so never appear in logs.
+//   - eth_getBalance returns VET balance. VTHO is the token of the builtin Energy contract, so its
+//     balance and transfers are accessible via eth_call and eth_getLogs like any other ERC20 token.
+//   - block tags 'latest', 'pending', 'safe' and 'finalized' all refer to the best block.
+package eth
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
+	"github.com/vechain/thor/xenv"
+)
+
+const (
+	maxBatchSize      = 100
+	maxCriteriaValues = 500 // max count of addresses and topics in a log filter
+	maxLogs           = 10000
+)
+
+type handlerFunc func(ctx context.Context, params json.RawMessage) (interface{}, error)
+
+type Eth struct {
+	repo         *chain.Repository
+	stater       *state.Stater
+	logDB        logdb.LogDB
+	callGasLimit uint64
+	forkConfig   thor.ForkConfig
+	handlers     map[string]handlerFunc
+}
+
+// New creates the JSON-RPC service. logDB can be nil, in which case eth_getLogs is unavailable.
+func New(
+	repo *chain.Repository,
+	stater *state.Stater,
+	logDB logdb.LogDB,
+	callGasLimit uint64,
+	forkConfig thor.ForkConfig,
+) *Eth {
+	e := &Eth{
+		repo:         repo,
+		stater:       stater,
+		logDB:        logDB,
+		callGasLimit: callGasLimit,
+		forkConfig:   forkConfig,
+	}
+	e.handlers = map[string]handlerFunc{
+		"eth_chainId":               e.chainID,
+		"eth_blockNumber":           e.blockNumber,
+		"eth_getBlockByNumber":      e.getBlockByNumber,
+		"eth_getBlockByHash":        e.getBlockByHash,
+		"eth_getTransactionReceipt": e.getTransactionReceipt,
+		"eth_getBalance":            e.getBalance,
+		"eth_getCode":               e.getCode,
+		"eth_getStorageAt":          e.getStorageAt,
+		"eth_call":                  e.call,
+		"eth_getLogs":               e.getLogs,
+	}
+	return e
+}
+
+func (e *Eth) handleRPC(w http.ResponseWriter, req *http.Request) error {
+	var body json.RawMessage
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return utils.WriteJSON(w, &rpcResponse{
+			Version: "2.0",
+			ID:      json.RawMessage("null"),
+			Error:   &rpcError{Code: codeParseError, Message: err.Error()},
+		})
+	}
+
+	// batch request
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return utils.WriteJSON(w, &rpcResponse{
+				Version: "2.0",
+				ID:      json.RawMessage("null"),
+				Error:   &rpcError{Code: codeParseError, Message: err.Error()},
+			})
+		}
+		if len(reqs) == 0 || len(reqs) > maxBatchSize {
+			return utils.WriteJSON(w, &rpcResponse{
+				Version: "2.0",
+				ID:      json.RawMessage("null"),
+				Error:   &rpcError{Code: codeInvalidRequest, Message: "batch size should be in range [1, " + strconv.Itoa(maxBatchSize) + "]"},
+			})
+		}
+		resps := make([]*rpcResponse, 0, len(reqs))
+		for _, r := range reqs {
+			if resp := e.serve(req.Context(), r); resp != nil {
+				resps = append(resps, resp)
+			}
+		}
+		if len(resps) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+		return utils.WriteJSON(w, resps)
+	}
+
+	resp := e.serve(req.Context(), body)
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	return utils.WriteJSON(w, resp)
+}
+
+// serve handles a single request. Nil returned for notification.
+func (e *Eth) serve(ctx context.Context, raw json.RawMessage) *rpcResponse {
+	var r rpcRequest
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return &rpcResponse{
+			Version: "2.0",
+			ID:      json.RawMessage("null"),
+			Error:   &rpcError{Code: codeInvalidRequest, Message: err.Error()},
+		}
+	}
+	if len(r.ID) == 0 {
+		// notification, no response expected
+		return nil
+	}
+
+	resp := &rpcResponse{Version: "2.0", ID: r.ID}
+	if r.Version != "2.0" {
+		resp.Error = &rpcError{Code: codeInvalidRequest, Message: "jsonrpc: should be 2.0"}
+		return resp
+	}
+	handler, ok := e.handlers[r.Method]
+	if !ok {
+		resp.Error = &rpcError{Code: codeMethodNotFound, Message: "the method " + r.Method + " does not exist/is not available"}
+		return resp
+	}
+
+	result, err := handler(ctx, r.Params)
+	if err != nil {
+		if re, ok := err.(*rpcError); ok {
+			resp.Error = re
+		} else {
+			resp.Error = &rpcError{Code: codeInternalError, Message: err.Error()}
+		}
+		return resp
+	}
+	if result == nil {
+		// null result should be explicitly presented
+		resp.Result = json.RawMessage("null")
+	} else {
+		resp.Result = result
+	}
+	return resp
+}
+
+// parseParams decodes positional params into args. The first 'required' args are mandatory.
+func parseParams(raw json.RawMessage, required int, args ...interface{}) error {
+	var params []json.RawMessage
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return invalidParams("params: %v", err)
+		}
+	}
+	if len(params) < required {
+		return invalidParams("missing value for required argument %d", len(params))
+	}
+	if len(params) > len(args) {
+		return invalidParams("too many arguments, want at most %d", len(args))
+	}
+	for i, p := range params {
+		if err := json.Unmarshal(p, args[i]); err != nil {
+			return invalidParams("invalid argument %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// parseBlockTag resolves block number or tag to the header on the best chain.
+// Nil returned if the block not found.
+func (e *Eth) parseBlockTag(tag string) (*block.Header, error) {
+	switch tag {
+	case "", "latest", "pending", "safe", "finalized":
+		return e.repo.BestBlock().Header(), nil
+	case "earliest":
+		return e.repo.GenesisBlock().Header(), nil
+	}
+	n, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return nil, invalidParams("block number: %v", err)
+	}
+	if n > math.MaxUint32 {
+		return nil, nil
+	}
+	header, err := e.repo.NewBestChain().GetBlockHeader(uint32(n))
+	if err != nil {
+		if e.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return header, nil
+}
+
+// parseStateTag resolves block tag for state access, which requires the block to be present.
+func (e *Eth) parseStateTag(tag string) (*block.Header, error) {
+	header, err := e.parseBlockTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, &rpcError{Code: codeServerError, Message: "header not found"}
+	}
+	return header, nil
+}
+
+func (e *Eth) chainID(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(e.repo.ChainTag()), nil
+}
+
+func (e *Eth) blockNumber(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(e.repo.BestBlock().Header().Number()), nil
+}
+
+func (e *Eth) getBlockByNumber(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		tag  string
+		full bool
+	)
+	if err := parseParams(params, 1, &tag, &full); err != nil {
+		return nil, err
+	}
+	header, err := e.parseBlockTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, nil
+	}
+	return e.getBlock(header.ID(), full)
+}
+
+func (e *Eth) getBlockByHash(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		id   thor.Bytes32
+		full bool
+	)
+	if err := parseParams(params, 1, &id, &full); err != nil {
+		return nil, err
+	}
+	return e.getBlock(id, full)
+}
+
+func (e *Eth) getBlock(id thor.Bytes32, full bool) (interface{}, error) {
+	summary, err := e.repo.GetBlockSummary(id)
+	if err != nil {
+		if e.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	txs := make([]interface{}, 0, len(summary.Txs))
+	if full {
+		b, err := e.repo.GetBlock(id)
+		if err != nil {
+			return nil, err
+		}
+		receipts, err := e.repo.GetBlockReceipts(id)
+		if err != nil {
+			return nil, err
+		}
+		for i, t := range b.Transactions() {
+			txs = append(txs, convertTransaction(t, summary.Header, uint64(i), receipts[i]))
+		}
+	} else {
+		for i := range summary.Txs {
+			txs = append(txs, &summary.Txs[i])
+		}
+	}
+	return convertBlock(summary, txs), nil
+}
+
+func (e *Eth) getTransactionReceipt(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var txID thor.Bytes32
+	if err := parseParams(params, 1, &txID); err != nil {
+		return nil, err
+	}
+	meta, err := e.repo.NewBestChain().GetTransactionMeta(txID)
+	if err != nil {
+		if e.repo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	b, err := e.repo.GetBlock(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := e.repo.GetBlockReceipts(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	return convertReceipt(b, receipts, meta.Index), nil
+}
+
+func (e *Eth) getBalance(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		addr thor.Address
+		tag  string
+	)
+	if err := parseParams(params, 1, &addr, &tag); err != nil {
+		return nil, err
+	}
+	header, err := e.parseStateTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := e.stater.NewState(header.StateRoot()).GetBalance(addr)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+func (e *Eth) getCode(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		addr thor.Address
+		tag  string
+	)
+	if err := parseParams(params, 1, &addr, &tag); err != nil {
+		return nil, err
+	}
+	header, err := e.parseStateTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	code, err := e.stater.NewState(header.StateRoot()).GetCode(addr)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(code), nil
+}
+
+func (e *Eth) getStorageAt(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		addr thor.Address
+		pos  string
+		tag  string
+	)
+	if err := parseParams(params, 2, &addr, &pos, &tag); err != nil {
+		return nil, err
+	}
+	key, err := parseStorageKey(pos)
+	if err != nil {
+		return nil, invalidParams("storage position: %v", err)
+	}
+	header, err := e.parseStateTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	value, err := e.stater.NewState(header.StateRoot()).GetStorage(addr, key)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// parseStorageKey accepts both quantity and full 32 bytes hex.
+func parseStorageKey(s string) (thor.Bytes32, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return thor.Bytes32{}, errors.New("missing 0x prefix")
+	}
+	s = s[2:]
+	if len(s) > 64 {
+		return thor.Bytes32{}, errors.New("too long")
+	}
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	return thor.BytesToBytes32(b), nil
+}
+
+func (e *Eth) call(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var (
+		args CallArgs
+		tag  string
+	)
+	if err := parseParams(params, 1, &args, &tag); err != nil {
+		return nil, err
+	}
+	header, err := e.parseStateTag(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := e.callGasLimit
+	if args.Gas != nil {
+		if uint64(*args.Gas) > e.callGasLimit {
+			return nil, invalidParams("gas: exceeds limit")
+		}
+		if *args.Gas > 0 {
+			gas = uint64(*args.Gas)
+		}
+	}
+	txCtx := &xenv.TransactionContext{
+		GasPrice:   new(big.Int),
+		ProvedWork: new(big.Int),
+	}
+	if args.GasPrice != nil {
+		txCtx.GasPrice = (*big.Int)(args.GasPrice)
+	}
+	if args.From != nil {
+		txCtx.Origin = *args.From
+		txCtx.GasPayer = *args.From
+	}
+	value := new(big.Int)
+	if args.Value != nil {
+		value = (*big.Int)(args.Value)
+	}
+	var data []byte
+	if args.Input != nil {
+		data = *args.Input
+	} else if args.Data != nil {
+		data = *args.Data
+	}
+
+	signer, _ := header.Signer()
+	rt := runtime.New(e.repo.NewChain(header.ParentID()), e.stater.NewState(header.StateRoot()),
+		&xenv.BlockContext{
+			Beneficiary: header.Beneficiary(),
+			Signer:      signer,
+			Number:      header.Number(),
+			Time:        header.Timestamp(),
+			GasLimit:    header.GasLimit(),
+			TotalScore:  header.TotalScore(),
+		},
+		e.forkConfig)
+
+	exec, interrupt := rt.PrepareClause(tx.NewClause(args.To).WithValue(value).WithData(data), 0, gas, txCtx)
+	type result struct {
+		output *runtime.Output
+		err    error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		out, _, err := exec()
+		resultCh <- result{out, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		interrupt()
+		return nil, ctx.Err()
+	case r := <-resultCh:
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.output.VMErr != nil {
+			if r.output.VMErr == vm.ErrExecutionReverted {
+				return nil, &rpcError{
+					Code:    codeReverted,
+					Message: "execution reverted",
+					Data:    hexutil.Bytes(r.output.Data),
+				}
+			}
+			return nil, &rpcError{Code: codeServerError, Message: r.output.VMErr.Error()}
+		}
+		return hexutil.Bytes(r.output.Data), nil
+	}
+}
+
+func (e *Eth) getLogs(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	if e.logDB == nil {
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "the method eth_getLogs is not available"}
+	}
+
+	var query FilterQuery
+	if err := parseParams(params, 1, &query); err != nil {
+		return nil, err
+	}
+
+	var rng logdb.Range
+	if query.BlockHash != nil {
+		if query.FromBlock != nil || query.ToBlock != nil {
+			return nil, invalidParams("cannot specify both blockHash and fromBlock/toBlock")
+		}
+		rng.From = block.Number(*query.BlockHash)
+		rng.To = rng.From
+	} else {
+		best := e.repo.BestBlock().Header().Number()
+		resolve := func(tag *string) (uint32, error) {
+			if tag == nil {
+				return best, nil
+			}
+			header, err := e.parseBlockTag(*tag)
+			if err != nil {
+				return 0, err
+			}
+			if header == nil {
+				// beyond best block
+				return best + 1, nil
+			}
+			return header.Number(), nil
+		}
+		var err error
+		if rng.From, err = resolve(query.FromBlock); err != nil {
+			return nil, err
+		}
+		if rng.To, err = resolve(query.ToBlock); err != nil {
+			return nil, err
+		}
+		if rng.From > rng.To {
+			return []*Log{}, nil
+		}
+	}
+
+	criteriaSet, err := buildCriteriaSet(query.Addresses, query.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := e.logDB.FilterEvents(ctx, &logdb.EventFilter{
+		CriteriaSet: criteriaSet,
+		Range:       &rng,
+		Options:     &logdb.Options{Limit: maxLogs + 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxLogs {
+		return nil, &rpcError{Code: codeServerError, Message: "query returned more than " + strconv.Itoa(maxLogs) + " results"}
+	}
+
+	var (
+		logs      = make([]*Log, 0, len(events))
+		txIndices = make(map[thor.Bytes32]uint64)
+	)
+	for _, ev := range events {
+		if query.BlockHash != nil && ev.BlockID != *query.BlockHash {
+			continue
+		}
+		txIndex, ok := txIndices[ev.TxID]
+		if !ok && ev.BlockNumber > 0 {
+			meta, err := e.repo.NewChain(ev.BlockID).GetTransactionMeta(ev.TxID)
+			if err != nil {
+				return nil, err
+			}
+			txIndex = meta.Index
+			txIndices[ev.TxID] = txIndex
+		}
+
+		l := &Log{
+			Address:          ev.Address,
+			Topics:           []thor.Bytes32{},
+			Data:             ev.Data,
+			BlockNumber:      hexutil.Uint64(ev.BlockNumber),
+			BlockHash:        ev.BlockID,
+			TransactionHash:  ev.TxID,
+			TransactionIndex: hexutil.Uint64(txIndex),
+			LogIndex:         hexutil.Uint64(ev.Index),
+		}
+		for _, topic := range ev.Topics {
+			if topic != nil {
+				l.Topics = append(l.Topics, *topic)
+			}
+		}
+		logs = append(logs, l)
+	}
+	return logs, nil
+}
+
+// buildCriteriaSet converts address and topic sets into the criteria of logdb, which matches any value of each set.
+func buildCriteriaSet(addresses AddressSet, topics []TopicSet) ([]*logdb.EventCriteria, error) {
+	if len(topics) > 5 {
+		return nil, invalidParams("topics: too many positions")
+	}
+
+	values := len(addresses)
+	criteria := &logdb.EventCriteria{Addresses: addresses}
+	for i, set := range topics {
+		values += len(set)
+		criteria.Topics[i] = set
+	}
+	if values == 0 {
+		// no criteria at all
+		return nil, nil
+	}
+	if values > maxCriteriaValues {
+		return nil, invalidParams("too many addresses and topics, max %d", maxCriteriaValues)
+	}
+	return []*logdb.EventCriteria{criteria}, nil
+}
+
+func (e *Eth) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(e.handleRPC))
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package eth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/eth"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var (
+	ts         *httptest.Server
+	repo       *chain.Repository
+	to         = thor.BytesToAddress([]byte("to"))
+	value      = big.NewInt(10000)
+	energyTx   *tx.Transaction
+	transferTx *tx.Transaction
+)
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
+
+func TestEth(t *testing.T) {
+	initEthServer(t)
+	defer ts.Close()
+
+	handleRequests(t)
+	chainID(t)
+	blockNumber(t)
+	getBlock(t)
+	getTransactionReceipt(t)
+	getBalance(t)
+	getCodeAndStorage(t)
+	call(t)
+	getLogs(t)
+}
+
+func initEthServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	gene := genesis.NewDevnet()
+
+	b, _, _, err := gene.Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ = chain.NewRepository(db, b)
+
+	logDB, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transferTx = buildTxWithClauses(t, repo.ChainTag(), tx.NewClause(&to).WithValue(value))
+	method, _ := builtin.Energy.ABI.MethodByName("transfer")
+	data, err := method.EncodeInput(to, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	energyTx = buildTxWithClauses(t, repo.ChainTag(), tx.NewClause(&builtin.Energy.Address).WithData(data))
+	packTxs(t, repo, stater, logDB, transferTx, energyTx)
+
+	router := mux.NewRouter()
+	eth.New(repo, stater, logDB, math.MaxUint64, thor.NoFork).Mount(router, "/rpc")
+	ts = httptest.NewServer(router)
+}
+
+func buildTxWithClauses(t *testing.T, chainTag byte, clauses ...*tx.Clause) *tx.Transaction {
+	builder := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(10).
+		Gas(1000000).
+		Nonce(uint64(time.Now().UnixNano()))
+	for _, c := range clauses {
+		builder.Clause(c)
+	}
+
+	transaction := builder.Build()
+	sig, err := crypto.Sign(transaction.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transaction.WithSignature(sig)
+}
+
+func packTxs(t *testing.T, repo *chain.Repository, stater *state.Stater, logDB logdb.LogDB, txs ...*tx.Transaction) {
+	best := repo.BestBlock()
+	packer := packer.New(repo, stater, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address, thor.NoFork)
+	flow, err := packer.Schedule(best.Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, transaction := range txs {
+		if err := flow.Adopt(transaction); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.AddBlock(b, receipts); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetBestBlockID(b.Header().ID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		return w.Write(b, receipts)
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func handleRequests(t *testing.T) {
+	res, statusCode := httpPost(t, ts.URL+"/rpc", "{")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var resp response
+	if err := json.Unmarshal(res, &resp); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, -32700, resp.Error.Code, "parse error")
+
+	assert.Equal(t, -32601, rpcCall(t, "eth_unknown").Error.Code, "method not found")
+	assert.Equal(t, -32602, rpcCall(t, "eth_getBalance", "abc").Error.Code, "invalid params")
+
+	// notification gets no response
+	_, statusCode = httpPost(t, ts.URL+"/rpc", `{"jsonrpc":"2.0","method":"eth_blockNumber"}`)
+	assert.Equal(t, http.StatusNoContent, statusCode)
+
+	res, statusCode = httpPost(t, ts.URL+"/rpc", `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},
+		{"jsonrpc":"2.0","method":"eth_chainId"},
+		{"jsonrpc":"2.0","id":"2","method":"eth_blockNumber"}
+	]`)
+	assert.Equal(t, http.StatusOK, statusCode)
+	var resps []response
+	if err := json.Unmarshal(res, &resps); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(resps), "notification in batch gets no response")
+	assert.Equal(t, `1`, string(resps[0].ID))
+	assert.Equal(t, `"2"`, string(resps[1].ID))
+}
+
+func chainID(t *testing.T) {
+	var id hexutil.Uint64
+	rpcResult(t, &id, "eth_chainId")
+	assert.Equal(t, hexutil.Uint64(repo.ChainTag()), id)
+}
+
+func blockNumber(t *testing.T) {
+	var num hexutil.Uint64
+	rpcResult(t, &num, "eth_blockNumber")
+	assert.Equal(t, hexutil.Uint64(1), num)
+}
+
+func getBlock(t *testing.T) {
+	var blk eth.Block
+	rpcResult(t, &blk, "eth_getBlockByNumber", "latest", false)
+	assert.Equal(t, repo.BestBlock().Header().ID(), blk.Hash)
+	assert.Equal(t, 2, len(blk.Transactions))
+	assert.Equal(t, transferTx.ID().String(), blk.Transactions[0])
+
+	var full struct {
+		Transactions []*eth.Transaction `json:"transactions"`
+	}
+	rpcResult(t, &full, "eth_getBlockByHash", blk.Hash.String(), true)
+	assert.Equal(t, 2, len(full.Transactions))
+	assert.Equal(t, transferTx.ID(), full.Transactions[0].Hash)
+	assert.Equal(t, to, *full.Transactions[0].To)
+	assert.Equal(t, value, full.Transactions[0].Value.ToInt())
+	assert.Equal(t, genesis.DevAccounts()[0].Address, full.Transactions[1].From)
+	assert.Equal(t, hexutil.Uint64(1), full.Transactions[1].TransactionIndex)
+
+	resp := rpcCall(t, "eth_getBlockByNumber", "0x100", false)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "null", string(resp.Result), "block not found")
+
+	resp = rpcCall(t, "eth_getBlockByHash", thor.Bytes32{}.String(), false)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "null", string(resp.Result), "block not found")
+}
+
+func getTransactionReceipt(t *testing.T) {
+	var receipt eth.Receipt
+	rpcResult(t, &receipt, "eth_getTransactionReceipt", energyTx.ID().String())
+	assert.Equal(t, energyTx.ID(), receipt.TransactionHash)
+	assert.Equal(t, hexutil.Uint64(1), receipt.TransactionIndex)
+	assert.Equal(t, hexutil.Uint64(1), receipt.Status)
+	assert.True(t, receipt.CumulativeGasUsed > receipt.GasUsed)
+	assert.Equal(t, 1, len(receipt.Logs))
+	assert.Equal(t, builtin.Energy.Address, receipt.Logs[0].Address)
+
+	resp := rpcCall(t, "eth_getTransactionReceipt", thor.Bytes32{}.String())
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, "null", string(resp.Result), "tx not found")
+}
+
+func getBalance(t *testing.T) {
+	var balance hexutil.Big
+	rpcResult(t, &balance, "eth_getBalance", to.String(), "latest")
+	assert.Equal(t, value, balance.ToInt())
+
+	rpcResult(t, &balance, "eth_getBalance", to.String(), "earliest")
+	assert.Equal(t, 0, balance.ToInt().Sign())
+
+	resp := rpcCall(t, "eth_getBalance", to.String(), "0x100")
+	assert.Equal(t, -32000, resp.Error.Code, "header not found")
+}
+
+func getCodeAndStorage(t *testing.T) {
+	var code hexutil.Bytes
+	rpcResult(t, &code, "eth_getCode", builtin.Energy.Address.String())
+	assert.True(t, len(code) > 0)
+
+	var storage thor.Bytes32
+	rpcResult(t, &storage, "eth_getStorageAt", to.String(), "0x0", "latest")
+	assert.Equal(t, thor.Bytes32{}, storage)
+
+	resp := rpcCall(t, "eth_getStorageAt", to.String(), "0xzz")
+	assert.Equal(t, -32602, resp.Error.Code, "invalid position")
+}
+
+func call(t *testing.T) {
+	method, _ := builtin.Energy.ABI.MethodByName("symbol")
+	data, _ := method.EncodeInput()
+	var output hexutil.Bytes
+	rpcResult(t, &output, "eth_call", map[string]interface{}{
+		"to":   builtin.Energy.Address.String(),
+		"data": hexutil.Bytes(data),
+	}, "latest")
+	var symbol string
+	if err := method.DecodeOutput(output, &symbol); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "VTHO", symbol)
+
+	// transfer more than balance reverts
+	method, _ = builtin.Energy.ABI.MethodByName("transfer")
+	data, _ = method.EncodeInput(to, big.NewInt(1))
+	resp := rpcCall(t, "eth_call", map[string]interface{}{
+		"from":  thor.BytesToAddress([]byte("nobody")).String(),
+		"to":    builtin.Energy.Address.String(),
+		"input": hexutil.Bytes(data),
+	}, "latest")
+	assert.Equal(t, 3, resp.Error.Code, "reverted")
+}
+
+func getLogs(t *testing.T) {
+	var logs []*eth.Log
+	rpcResult(t, &logs, "eth_getLogs", map[string]interface{}{
+		"fromBlock": "earliest",
+		"address":   builtin.Energy.Address.String(),
+	})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, energyTx.ID(), logs[0].TransactionHash)
+	assert.Equal(t, hexutil.Uint64(1), logs[0].TransactionIndex)
+	assert.Equal(t, thor.BytesToBytes32(to.Bytes()), logs[0].Topics[2])
+
+	// topic OR-set on position 2
+	rpcResult(t, &logs, "eth_getLogs", map[string]interface{}{
+		"blockHash": repo.BestBlock().Header().ID().String(),
+		"topics":    []interface{}{nil, nil, []thor.Bytes32{{}, thor.BytesToBytes32(to.Bytes())}},
+	})
+	assert.Equal(t, 1, len(logs))
+
+	rpcResult(t, &logs, "eth_getLogs", map[string]interface{}{
+		"address": []thor.Address{thor.BytesToAddress([]byte("none"))},
+	})
+	assert.Equal(t, 0, len(logs))
+
+	resp := rpcCall(t, "eth_getLogs", map[string]interface{}{
+		"blockHash": repo.BestBlock().Header().ID().String(),
+		"fromBlock": "0x0",
+	})
+	assert.Equal(t, -32602, resp.Error.Code, "blockHash with range")
+}
+
+func rpcResult(t *testing.T, result interface{}, method string, params ...interface{}) {
+	resp := rpcCall(t, method, params...)
+	if resp.Error != nil {
+		t.Fatalf("%s: %s", method, resp.Error.Message)
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func rpcCall(t *testing.T, method string, params ...interface{}) *response {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, statusCode := httpPost(t, ts.URL+"/rpc", string(body))
+	assert.Equal(t, http.StatusOK, statusCode)
+	var resp response
+	if err := json.Unmarshal(res, &resp); err != nil {
+		t.Fatal(err)
+	}
+	return &resp
+}
+
+func httpPost(t *testing.T, url string, body string) ([]byte, int) {
+	res, err := http.Post(url, "application/json", bytes.NewReader([]byte(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, res.StatusCode
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2020 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package eth
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// JSON-RPC 2.0 error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+	codeServerError    = -32000
+	codeReverted       = 3 // as geth does for eth_call
+)
+
+var (
+	emptyUncleHash = thor.Bytes32(types.EmptyUncleHash)
+	emptyNonce     = hexutil.Bytes(make([]byte, 8))
+	emptyBloom     = hexutil.Bytes(make([]byte, types.BloomByteLength))
+)
+
+type rpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf(format, args...)}
+}
+
+// Block is the eth compatible block object.
+type Block struct {
+	Number           hexutil.Uint64 `json:"number"`
+	Hash             thor.Bytes32   `json:"hash"`
+	ParentHash       thor.Bytes32   `json:"parentHash"`
+	Nonce            hexutil.Bytes  `json:"nonce"`
+	Sha3Uncles       thor.Bytes32   `json:"sha3Uncles"`
+	LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+	TransactionsRoot thor.Bytes32   `json:"transactionsRoot"`
+	StateRoot        thor.Bytes32   `json:"stateRoot"`
+	ReceiptsRoot     thor.Bytes32   `json:"receiptsRoot"`
+	Miner            thor.Address   `json:"miner"`
+	Difficulty       hexutil.Uint64 `json:"difficulty"`
+	TotalDifficulty  hexutil.Uint64 `json:"totalDifficulty"`
+	ExtraData        hexutil.Bytes  `json:"extraData"`
+	Size             hexutil.Uint64 `json:"size"`
+	GasLimit         hexutil.Uint64 `json:"gasLimit"`
+	GasUsed          hexutil.Uint64 `json:"gasUsed"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+	Transactions     []interface{}  `json:"transactions"`
+	Uncles           []thor.Bytes32 `json:"uncles"`
+}
+
+// Clause is the clause of a thor tx, appended to eth tx object.
+type Clause struct {
+	To    *thor.Address `json:"to"`
+	Value *hexutil.Big  `json:"value"`
+	Data  hexutil.Bytes `json:"data"`
+}
+
+// Transaction is the eth compatible transaction object.
+// To, Value and Input mirror the first clause, while all clauses are listed in Clauses.
+type Transaction struct {
+	Hash             thor.Bytes32   `json:"hash"`
+	Nonce            hexutil.Uint64 `json:"nonce"`
+	BlockHash        thor.Bytes32   `json:"blockHash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	From             thor.Address   `json:"from"`
+	To               *thor.Address  `json:"to"`
+	Value            *hexutil.Big   `json:"value"`
+	Gas              hexutil.Uint64 `json:"gas"`
+	GasPrice         *hexutil.Big   `json:"gasPrice"`
+	Input            hexutil.Bytes  `json:"input"`
+
+	// thor specific
+	Clauses   []*Clause     `json:"clauses"`
+	Delegator *thor.Address `json:"delegator"`
+}
+
+// Log is the eth compatible log object.
+type Log struct {
+	Address          thor.Address   `json:"address"`
+	Topics           []thor.Bytes32 `json:"topics"`
+	Data             hexutil.Bytes  `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        thor.Bytes32   `json:"blockHash"`
+	TransactionHash  thor.Bytes32   `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+// Receipt is the eth compatible receipt object.
+type Receipt struct {
+	TransactionHash   thor.Bytes32   `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash         thor.Bytes32   `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	From              thor.Address   `json:"from"`
+	To                *thor.Address  `json:"to"`
+	CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	ContractAddress   *thor.Address  `json:"contractAddress"`
+	Logs              []*Log         `json:"logs"`
+	LogsBloom         hexutil.Bytes  `json:"logsBloom"`
+	Status            hexutil.Uint64 `json:"status"`
+
+	// thor specific
+	GasPayer thor.Address `json:"gasPayer"`
+	Paid     *hexutil.Big `json:"paid"`
+	Reward   *hexutil.Big `json:"reward"`
+}
+
+// CallArgs is the call object of eth_call.
+type CallArgs struct {
+	From     *thor.Address   `json:"from"`
+	To       *thor.Address   `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+// FilterQuery is the filter object of eth_getLogs.
+type FilterQuery struct {
+	BlockHash *thor.Bytes32 `json:"blockHash"`
+	FromBlock *string       `json:"fromBlock"`
+	ToBlock   *string       `json:"toBlock"`
+	Addresses AddressSet    `json:"address"`
+	Topics    []TopicSet    `json:"topics"`
+}
+
+// AddressSet accepts either a single address or an array of addresses.
+type AddressSet []thor.Address
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *AddressSet) UnmarshalJSON(data []byte) error {
+	var list []thor.Address
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+	var single *thor.Address
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	if single != nil {
+		*s = AddressSet{*single}
+	}
+	return nil
+}
+
+// TopicSet accepts null, a single topic or an array of topics for one topic position.
+type TopicSet []thor.Bytes32
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *TopicSet) UnmarshalJSON(data []byte) error {
+	var list []thor.Bytes32
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+	var single *thor.Bytes32
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	if single != nil {
+		*s = TopicSet{*single}
+	}
+	return nil
+}
+
+func convertBlock(summary *chain.BlockSummary, txs []interface{}) *Block {
+	header := summary.Header
+	return &Block{
+		Number:           hexutil.Uint64(header.Number()),
+		Hash:             header.ID(),
+		ParentHash:       header.ParentID(),
+		Nonce:            emptyNonce,
+		Sha3Uncles:       emptyUncleHash,
+		LogsBloom:        emptyBloom,
+		TransactionsRoot: header.TxsRoot(),
+		StateRoot:        header.StateRoot(),
+		ReceiptsRoot:     header.ReceiptsRoot(),
+		Miner:            header.Beneficiary(),
+		TotalDifficulty:  hexutil.Uint64(header.TotalScore()),
+		ExtraData:        hexutil.Bytes{},
+		Size:             hexutil.Uint64(summary.Size),
+		GasLimit:         hexutil.Uint64(header.GasLimit()),
+		GasUsed:          hexutil.Uint64(header.GasUsed()),
+		Timestamp:        hexutil.Uint64(header.Timestamp()),
+		Transactions:     txs,
+		Uncles:           []thor.Bytes32{},
+	}
+}
+
+// effectiveGasPrice derives the gas price actually paid from the receipt.
+func effectiveGasPrice(receipt *tx.Receipt) *big.Int {
+	if receipt.GasUsed == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Div(receipt.Paid, new(big.Int).SetUint64(receipt.GasUsed))
+}
+
+func convertTransaction(t *tx.Transaction, header *block.Header, index uint64, receipt *tx.Receipt) *Transaction {
+	origin, _ := t.Origin()
+	delegator, _ := t.Delegator()
+
+	clauses := make([]*Clause, 0, len(t.Clauses()))
+	for _, c := range t.Clauses() {
+		clauses = append(clauses, &Clause{
+			To:    c.To(),
+			Value: (*hexutil.Big)(c.Value()),
+			Data:  c.Data(),
+		})
+	}
+
+	etx := &Transaction{
+		Hash:             t.ID(),
+		Nonce:            hexutil.Uint64(t.Nonce()),
+		BlockHash:        header.ID(),
+		BlockNumber:      hexutil.Uint64(header.Number()),
+		TransactionIndex: hexutil.Uint64(index),
+		From:             origin,
+		Value:            (*hexutil.Big)(new(big.Int)),
+		Gas:              hexutil.Uint64(t.Gas()),
+		GasPrice:         (*hexutil.Big)(effectiveGasPrice(receipt)),
+		Input:            hexutil.Bytes{},
+		Clauses:          clauses,
+		Delegator:        delegator,
+	}
+	if len(clauses) > 0 {
+		etx.To = clauses[0].To
+		etx.Value = clauses[0].Value
+		etx.Input = clauses[0].Data
+	}
+	return etx
+}
+
+// convertReceipt converts the receipt of the tx at given index in the block.
+// receipts are all receipts of the block, used to compute cumulative gas and log index.
+func convertReceipt(b *block.Block, receipts tx.Receipts, index uint64) *Receipt {
+	var (
+		header   = b.Header()
+		t        = b.Transactions()[index]
+		receipt  = receipts[index]
+		logIndex uint64
+		cumGas   uint64
+	)
+	origin, _ := t.Origin()
+	for i := uint64(0); i < index; i++ {
+		cumGas += receipts[i].GasUsed
+		for _, o := range receipts[i].Outputs {
+			logIndex += uint64(len(o.Events))
+		}
+	}
+	cumGas += receipt.GasUsed
+
+	r := &Receipt{
+		TransactionHash:   t.ID(),
+		TransactionIndex:  hexutil.Uint64(index),
+		BlockHash:         header.ID(),
+		BlockNumber:       hexutil.Uint64(header.Number()),
+		From:              origin,
+		CumulativeGasUsed: hexutil.Uint64(cumGas),
+		GasUsed:           hexutil.Uint64(receipt.GasUsed),
+		EffectiveGasPrice: (*hexutil.Big)(effectiveGasPrice(receipt)),
+		Logs:              []*Log{},
+		LogsBloom:         emptyBloom,
+		GasPayer:          receipt.GasPayer,
+		Paid:              (*hexutil.Big)(receipt.Paid),
+		Reward:            (*hexutil.Big)(receipt.Reward),
+	}
+	if !receipt.Reverted {
+		r.Status = 1
+	}
+	if clauses := t.Clauses(); len(clauses) > 0 {
+		r.To = clauses[0].To()
+	}
+	for i, o := range receipt.Outputs {
+		if r.ContractAddress == nil && t.Clauses()[i].To() == nil {
+			addr := thor.CreateContractAddress(t.ID(), uint32(i), 0)
+			r.ContractAddress = &addr
+		}
+		for _, ev := range o.Events {
+			r.Logs = append(r.Logs, &Log{
+				Address:          ev.Address,
+				Topics:           append([]thor.Bytes32{}, ev.Topics...),
+				Data:             ev.Data,
+				BlockNumber:      r.BlockNumber,
+				BlockHash:        r.BlockHash,
+				TransactionHash:  r.TransactionHash,
+				TransactionIndex: r.TransactionIndex,
+				LogIndex:         hexutil.Uint64(logIndex),
+			})
+			logIndex++
+		}
+	}
+	return r
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package events
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+)
+
+// DecodedEvent is the event decoded with the given ABI.
+type DecodedEvent struct {
+	Event string                 `json:"event"`
+	Args  map[string]interface{} `json:"args"`
+}
+
+// ParseEventABI parses the ABI fragment (JSON object) of a single event.
+func ParseEventABI(data []byte) (*abi.Event, error) {
+	parsed, err := abi.New(append(append([]byte{'['}, data...), ']'))
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.Events()) != 1 || len(parsed.Methods()) > 0 || parsed.Constructor() != nil {
+		return nil, errors.New("expected exactly one event")
+	}
+	return parsed.Events()[0], nil
+}
+
+// DecodeEvent decodes the event into named args.
+// Integers are in decimal string, bytes and addresses are in hex string.
+func DecodeEvent(ev *abi.Event, topics []thor.Bytes32, data []byte) (*DecodedEvent, error) {
+	decoded, err := ev.DecodeWithTopics(topics, data)
+	if err != nil {
+		return nil, err
+	}
+	args := make(map[string]interface{}, len(decoded))
+	for k, v := range decoded {
+		args[k] = jsonValue(v)
+	}
+	return &DecodedEvent{
+		Event: ev.Name(),
+		Args:  args,
+	}, nil
+}
+
+// jsonValue converts the value unpacked by abi into json friendly form.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return thor.Address(v).String()
+	case thor.Bytes32:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case bool, string:
+		return v
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array, reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// fixed bytes
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		elems := make([]interface{}, rv.Len())
+		for i := range elems {
+			elems[i] = jsonValue(rv.Index(i).Interface())
+		}
+		return elems
+	}
+	return v
+}
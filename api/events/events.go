@@ -9,19 +9,25 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
 )
 
+// response header carries the cursor of the last returned event
+const nextCursorHeader = "x-thorest-next"
+
 type Events struct {
 	repo *chain.Repository
-	db   *logdb.LogDB
+	db   logdb.LogDB
 }
 
-func New(repo *chain.Repository, db *logdb.LogDB) *Events {
+func New(repo *chain.Repository, db logdb.LogDB) *Events {
 	return &Events{
 		repo,
 		db,
@@ -29,21 +35,48 @@ func New(repo *chain.Repository, db *logdb.LogDB) *Events {
 }
 
 //Filter query events with option
-func (e *Events) filter(ctx context.Context, ef *EventFilter) ([]*FilteredEvent, error) {
-	chain := e.repo.NewBestChain()
-	filter, err := convertEventFilter(chain, ef)
+func (e *Events) filter(ctx context.Context, ef *EventFilter) ([]*FilteredEvent, *uint64, error) {
+	filter, abis, err := convertEventFilter(ef)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err := CheckRetained(e.repo, e.db, filter.Range, filter.TimeRange); err != nil {
+		return nil, nil, err
 	}
 	events, err := e.db.FilterEvents(ctx, filter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	fes := make([]*FilteredEvent, len(events))
 	for i, e := range events {
 		fes[i] = convertEvent(e)
+		fes[i].Decoded = decodeMatched(e, filter.CriteriaSet, abis)
+	}
+	if len(events) == 0 {
+		return fes, nil, nil
+	}
+	next := events[len(events)-1].Cursor()
+	return fes, &next, nil
+}
+
+// decodeMatched decodes the event with the ABI of the first matched criteria which has one.
+// The event is left undecoded if it doesn't fit the ABI.
+func decodeMatched(ev *logdb.Event, criteriaSet []*logdb.EventCriteria, abis []*abi.Event) *DecodedEvent {
+	for i, c := range criteriaSet {
+		if abis[i] == nil || !c.Match(ev) {
+			continue
+		}
+		var topics []thor.Bytes32
+		for _, t := range ev.Topics {
+			if t != nil {
+				topics = append(topics, *t)
+			}
+		}
+		if decoded, err := DecodeEvent(abis[i], topics, ev.Data); err == nil {
+			return decoded
+		}
 	}
-	return fes, nil
+	return nil
 }
 
 func (e *Events) handleFilter(w http.ResponseWriter, req *http.Request) error {
@@ -51,15 +84,44 @@ func (e *Events) handleFilter(w http.ResponseWriter, req *http.Request) error {
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	fes, err := e.filter(req.Context(), &filter)
+	fes, next, err := e.filter(req.Context(), &filter)
 	if err != nil {
 		return err
 	}
+	if next != nil {
+		w.Header().Set(nextCursorHeader, hexutil.EncodeUint64(*next))
+	}
 	return utils.WriteJSON(w, fes)
 }
 
+func (e *Events) handleStats(w http.ResponseWriter, req *http.Request) error {
+	var filter EventStatsFilter
+	if err := utils.ParseJSON(req.Body, &filter); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if err := ValidateGrouping(filter.GroupBy, filter.BucketSize, logdb.GroupByAddress); err != nil {
+		return err
+	}
+	f, _, err := convertEventFilter(&filter.EventFilter)
+	if err != nil {
+		return err
+	}
+	if f.Options, err = StatsOptions(f.Options); err != nil {
+		return err
+	}
+	if err := CheckRetained(e.repo, e.db, f.Range, f.TimeRange); err != nil {
+		return err
+	}
+	stats, err := e.db.EventStats(req.Context(), f, filter.GroupBy, filter.BucketSize)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, ConvertStats(stats))
+}
+
 func (e *Events) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(e.handleFilter))
+	sub.Path("/stats").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(e.handleStats))
 }
@@ -5,4 +5,144 @@
 
 package events_test
 
-// TODO
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestValidateGrouping(t *testing.T) {
+	assert.Nil(t, events.ValidateGrouping(logdb.GroupByBlock, 8640))
+	assert.Nil(t, events.ValidateGrouping(logdb.GroupByBlock, 1<<24))
+	assert.NotNil(t, events.ValidateGrouping(logdb.GroupByBlock, 0))
+	assert.NotNil(t, events.ValidateGrouping(logdb.GroupByBlock, 1<<24+1))
+
+	assert.Nil(t, events.ValidateGrouping(logdb.GroupByAddress, 0, logdb.GroupByAddress))
+	assert.NotNil(t, events.ValidateGrouping(logdb.GroupBySender, 0, logdb.GroupByAddress))
+}
+
+func TestStatsOptions(t *testing.T) {
+	options, err := events.StatsOptions(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &logdb.Options{Limit: 1000}, options)
+
+	options, err = events.StatsOptions(&logdb.Options{Offset: 10, Limit: 10000})
+	assert.Nil(t, err)
+	assert.Equal(t, &logdb.Options{Offset: 10, Limit: 10000}, options)
+
+	_, err = events.StatsOptions(&logdb.Options{Limit: 10001})
+	assert.NotNil(t, err)
+}
+
+func TestFilterPaged(t *testing.T) {
+	db := muxdb.NewMem()
+	b, _, _, err := genesis.NewDevnet().Build(state.NewStater(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+	logDB, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logDB.Close()
+
+	router := mux.NewRouter()
+	events.New(repo, logDB).Mount(router, "/logs/event")
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	// appends a block with 3 events
+	appendBlock := func() {
+		trx := new(tx.Builder).Nonce(uint64(b.Header().Number())).Build()
+		sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b = new(block.Builder).ParentID(b.Header().ID()).Transaction(trx.WithSignature(sig)).Build()
+		var evs tx.Events
+		for i := 0; i < 3; i++ {
+			evs = append(evs, &tx.Event{Address: thor.BytesToAddress([]byte{byte(i)}), Data: []byte{byte(i)}})
+		}
+		if err := logDB.Log(func(w *logdb.Writer) error {
+			return w.Write(b, tx.Receipts{{Outputs: []*tx.Output{{Events: evs}}}})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	filter := func(f *events.EventFilter) (got []*events.FilteredEvent, next string) {
+		body, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := http.Post(ts.URL+"/logs/event", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		data, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, res.StatusCode, string(data))
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		return got, res.Header.Get("x-thorest-next")
+	}
+	// pages through by cursors, and appends a block after the first page
+	pageAll := func(order logdb.Order) (paged []*events.FilteredEvent) {
+		var cursor *math.HexOrDecimal64
+		for {
+			got, next := filter(&events.EventFilter{Cursor: cursor, Options: &logdb.Options{Limit: 2}, Order: order})
+			if len(got) == 0 {
+				assert.Equal(t, "", next, "no cursor if exhausted")
+				return
+			}
+			if paged == nil {
+				appendBlock()
+			}
+			paged = append(paged, got...)
+
+			n, err := hexutil.DecodeUint64(next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cursor = (*math.HexOrDecimal64)(&n)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		appendBlock()
+	}
+
+	// logs appended while paging come at the end
+	paged := pageAll(logdb.ASC)
+	all, _ := filter(&events.EventFilter{})
+	assert.Equal(t, 12, len(all))
+	assert.Equal(t, all, paged)
+
+	// logs appended while paging are not included
+	paged = pageAll(logdb.DESC)
+	desc, _ := filter(&events.EventFilter{Order: logdb.DESC})
+	assert.Equal(t, 15, len(desc))
+	assert.Equal(t, desc[3:], paged)
+}
@@ -6,11 +6,15 @@
 package events
 
 import (
+	"encoding/json"
 	"fmt"
-	"math"
+	"net/http"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/vechain/thor/block"
+	gmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/thor"
@@ -25,12 +29,56 @@ type LogMeta struct {
 	ClauseIndex    uint32       `json:"clauseIndex"`
 }
 
+// max count of addresses and topic values in the criteria set of one filter
+const maxCriteriaValues = 500
+
+// AddressSet accepts either a single address or an array of addresses.
+type AddressSet []thor.Address
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *AddressSet) UnmarshalJSON(data []byte) error {
+	var list []thor.Address
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+	var single *thor.Address
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	if single != nil {
+		*s = AddressSet{*single}
+	}
+	return nil
+}
+
+// Bytes32Set accepts null, a single value or an array of values, e.g. for one topic position.
+type Bytes32Set []thor.Bytes32
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Bytes32Set) UnmarshalJSON(data []byte) error {
+	var list []thor.Bytes32
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+	var single *thor.Bytes32
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+	if single != nil {
+		*s = Bytes32Set{*single}
+	}
+	return nil
+}
+
+// TopicSet matches any of the values for each topic position.
 type TopicSet struct {
-	Topic0 *thor.Bytes32 `json:"topic0"`
-	Topic1 *thor.Bytes32 `json:"topic1"`
-	Topic2 *thor.Bytes32 `json:"topic2"`
-	Topic3 *thor.Bytes32 `json:"topic3"`
-	Topic4 *thor.Bytes32 `json:"topic4"`
+	Topic0 Bytes32Set `json:"topic0"`
+	Topic1 Bytes32Set `json:"topic1"`
+	Topic2 Bytes32Set `json:"topic2"`
+	Topic3 Bytes32Set `json:"topic3"`
+	Topic4 Bytes32Set `json:"topic4"`
 }
 
 // FilteredEvent only comes from one contract
@@ -39,6 +87,7 @@ type FilteredEvent struct {
 	Topics  []*thor.Bytes32 `json:"topics"`
 	Data    string          `json:"data"`
 	Meta    LogMeta         `json:"meta"`
+	Decoded *DecodedEvent   `json:"decoded,omitempty"`
 }
 
 //convert a logdb.Event into a json format Event
@@ -90,45 +139,174 @@ func (e *FilteredEvent) String() string {
 }
 
 type EventCriteria struct {
-	Address *thor.Address `json:"address"`
+	Address  AddressSet      `json:"address"` // any of the addresses
+	TxID     *thor.Bytes32   `json:"txID"`
+	TxOrigin *thor.Address   `json:"txOrigin"`
+	ABI      json.RawMessage `json:"abi"` // event ABI to decode matched events
 	TopicSet
 }
 
 type EventFilter struct {
-	CriteriaSet []*EventCriteria `json:"criteriaSet"`
-	Range       *Range           `json:"range"`
-	Options     *logdb.Options   `json:"options"`
-	Order       logdb.Order      `json:"order"`
+	CriteriaSet []*EventCriteria      `json:"criteriaSet"`
+	Range       *Range                `json:"range"`
+	TimeRange   *TimeRange            `json:"timeRange"`
+	Cursor      *gmath.HexOrDecimal64 `json:"cursor"`
+	Options     *logdb.Options        `json:"options"`
+	Order       logdb.Order           `json:"order"`
 }
 
-func convertEventFilter(chain *chain.Chain, filter *EventFilter) (*logdb.EventFilter, error) {
-	rng, err := ConvertRange(chain, filter.Range)
-	if err != nil {
-		return nil, err
-	}
+// convertEventFilter converts the filter into logdb filter, with parsed event ABIs along with the criteria set.
+func convertEventFilter(filter *EventFilter) (*logdb.EventFilter, []*abi.Event, error) {
+	rng, trng := ConvertRange(filter.Range, filter.TimeRange)
 	f := &logdb.EventFilter{
-		Range:   rng,
-		Options: filter.Options,
-		Order:   filter.Order,
+		Range:     rng,
+		TimeRange: trng,
+		Cursor:    (*uint64)(filter.Cursor),
+		Options:   filter.Options,
+		Order:     filter.Order,
 	}
+	var abis []*abi.Event
 	if len(filter.CriteriaSet) > 0 {
 		criterias := make([]*logdb.EventCriteria, len(filter.CriteriaSet))
+		abis = make([]*abi.Event, len(filter.CriteriaSet))
+		values := 0
 		for i, criteria := range filter.CriteriaSet {
-			var topics [5]*thor.Bytes32
-			topics[0] = criteria.Topic0
-			topics[1] = criteria.Topic1
-			topics[2] = criteria.Topic2
-			topics[3] = criteria.Topic3
-			topics[4] = criteria.Topic4
+			values += len(criteria.Address) + len(criteria.Topic0) + len(criteria.Topic1) +
+				len(criteria.Topic2) + len(criteria.Topic3) + len(criteria.Topic4)
+			if values > maxCriteriaValues {
+				return nil, nil, utils.BadRequest(fmt.Errorf("criteriaSet: too many addresses and topics, max %d", maxCriteriaValues))
+			}
+			if len(criteria.ABI) > 0 {
+				ev, err := ParseEventABI(criteria.ABI)
+				if err != nil {
+					return nil, nil, utils.BadRequest(errors.WithMessage(err, fmt.Sprintf("criteriaSet[%d].abi", i)))
+				}
+				abis[i] = ev
+			}
+			topics := [5][]thor.Bytes32{
+				criteria.Topic0,
+				criteria.Topic1,
+				criteria.Topic2,
+				criteria.Topic3,
+				criteria.Topic4,
+			}
+			if len(topics[0]) == 0 && abis[i] != nil && !abis[i].Anonymous() {
+				// match only the event described by the ABI
+				topics[0] = []thor.Bytes32{abis[i].ID()}
+			}
 			criteria := &logdb.EventCriteria{
-				Address: criteria.Address,
-				Topics:  topics,
+				Addresses: criteria.Address,
+				Topics:    topics,
+				TxID:      criteria.TxID,
+				TxOrigin:  criteria.TxOrigin,
 			}
 			criterias[i] = criteria
 		}
 		f.CriteriaSet = criterias
 	}
-	return f, nil
+	return f, abis, nil
+}
+
+// EventStatsFilter is the filter to aggregate events by groups.
+type EventStatsFilter struct {
+	EventFilter
+	GroupBy    logdb.GroupBy `json:"groupBy"`
+	BucketSize uint32        `json:"bucketSize"` // blocks per bucket, if grouped by block
+}
+
+// BlockRange is the block range bucket of stats.
+type BlockRange struct {
+	From uint32 `json:"from"`
+	To   uint32 `json:"to"`
+}
+
+// Stats is the aggregation of a group of logs.
+type Stats struct {
+	Range   *BlockRange            `json:"range,omitempty"`
+	Address *thor.Address          `json:"address,omitempty"`
+	Count   uint64                 `json:"count"`
+	Amount  *gmath.HexOrDecimal256 `json:"amount,omitempty"`
+}
+
+// ConvertStats converts logdb stats into json format.
+func ConvertStats(stats []*logdb.Stats) []*Stats {
+	converted := make([]*Stats, len(stats))
+	for i, st := range stats {
+		converted[i] = &Stats{
+			Address: st.Address,
+			Count:   st.Count,
+			Amount:  (*gmath.HexOrDecimal256)(st.Amount),
+		}
+		if st.Range != nil {
+			converted[i].Range = &BlockRange{
+				From: st.Range.From,
+				To:   st.Range.To,
+			}
+		}
+	}
+	return converted
+}
+
+const (
+	maxBucketSize     = 1 << 24 // blocks of about 5 years
+	defaultStatsLimit = 1000    // groups returned if not paged
+	maxStatsLimit     = 10000
+)
+
+// ValidateGrouping checks the grouping params against the allowed groups.
+func ValidateGrouping(groupBy logdb.GroupBy, bucketSize uint32, allowed ...logdb.GroupBy) error {
+	if groupBy == logdb.GroupByBlock {
+		if bucketSize == 0 {
+			return utils.BadRequest(errors.New("bucketSize: required if grouped by block"))
+		}
+		if bucketSize > maxBucketSize {
+			return utils.BadRequest(errors.Errorf("bucketSize: exceeds max %d", maxBucketSize))
+		}
+		return nil
+	}
+	for _, g := range allowed {
+		if g == groupBy {
+			return nil
+		}
+	}
+	return utils.BadRequest(errors.Errorf("groupBy: unsupported %q", groupBy))
+}
+
+// StatsOptions returns the options to page groups of stats, which are limited by default.
+func StatsOptions(options *logdb.Options) (*logdb.Options, error) {
+	if options == nil {
+		return &logdb.Options{Limit: defaultStatsLimit}, nil
+	}
+	if options.Limit > maxStatsLimit {
+		return nil, utils.BadRequest(errors.Errorf("options.limit: exceeds max %d", maxStatsLimit))
+	}
+	return options, nil
+}
+
+// CheckRetained returns an error if the ranges reach before the logs retained in the db.
+func CheckRetained(repo *chain.Repository, db logdb.LogDB, rng *logdb.Range, timeRange *logdb.TimeRange) error {
+	from := db.RetainedFrom()
+	if from == 0 {
+		return nil
+	}
+	if rng != nil && rng.From < from {
+		return utils.HTTPError(errors.Errorf("range: logs before block %v are pruned", from), http.StatusGone)
+	}
+	if timeRange != nil {
+		chain := repo.NewBestChain()
+		header, err := chain.GetBlockHeader(from)
+		if err != nil {
+			if !chain.IsNotFound(err) {
+				return err
+			}
+			// pruned beyond the best block
+			return utils.HTTPError(errors.Errorf("range: logs before block %v are pruned", from), http.StatusGone)
+		}
+		if timeRange.From < header.Timestamp() {
+			return utils.HTTPError(errors.Errorf("range: logs before time %v are pruned", header.Timestamp()), http.StatusGone)
+		}
+	}
+	return nil
 }
 
 type RangeType string
@@ -144,45 +322,45 @@ type Range struct {
 	To   uint64
 }
 
-func ConvertRange(chain *chain.Chain, r *Range) (*logdb.Range, error) {
+// TimeRange is the range of block timestamps, both ends inclusive.
+type TimeRange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ConvertRange converts the block or time range, combined with the optional extra time range, into logdb ranges.
+func ConvertRange(r *Range, timeRange *TimeRange) (*logdb.Range, *logdb.TimeRange) {
+	var (
+		rng  *logdb.Range
+		trng *logdb.TimeRange
+	)
+	if timeRange != nil {
+		trng = &logdb.TimeRange{
+			From: timeRange.From,
+			To:   timeRange.To,
+		}
+	}
 	if r == nil {
-		return nil, nil
+		return rng, trng
 	}
 	if r.Unit == TimeRangeType {
-		emptyRange := logdb.Range{
-			From: math.MaxUint32,
-			To:   math.MaxUint32,
-		}
-
-		genesis, err := chain.GetBlockHeader(0)
-		if err != nil {
-			return nil, err
-		}
-		if r.To < genesis.Timestamp() {
-			return &emptyRange, nil
-		}
-		head, err := chain.GetBlockHeader(block.Number(chain.HeadID()))
-		if r.From > head.Timestamp() {
-			return &emptyRange, nil
+		if trng == nil {
+			return rng, &logdb.TimeRange{
+				From: r.From,
+				To:   r.To,
+			}
 		}
-
-		fromHeader, err := chain.FindBlockHeaderByTimestamp(r.From, 1)
-		if err != nil {
-			return nil, err
+		// intersect with the extra time range
+		if r.From > trng.From {
+			trng.From = r.From
 		}
-
-		toHeader, err := chain.FindBlockHeaderByTimestamp(r.To, -1)
-		if err != nil {
-			return nil, err
+		if r.To < trng.To {
+			trng.To = r.To
 		}
-
-		return &logdb.Range{
-			From: fromHeader.Number(),
-			To:   toHeader.Number(),
-		}, nil
+		return rng, trng
 	}
 	return &logdb.Range{
 		From: uint32(r.From),
 		To:   uint32(r.To),
-	}, nil
+	}, trng
 }
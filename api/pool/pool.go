@@ -0,0 +1,202 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool
+
+import (
+	"bytes"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
+)
+
+type Pool struct {
+	repo   *chain.Repository
+	stater *state.Stater
+	pool   *txpool.TxPool
+}
+
+func New(repo *chain.Repository, stater *state.Stater, pool *txpool.TxPool) *Pool {
+	return &Pool{
+		repo,
+		stater,
+		pool,
+	}
+}
+
+// executableSet returns ids of executable txs, which is refreshed by the pool's housekeeping.
+func (p *Pool) executableSet() map[thor.Bytes32]bool {
+	executables := p.pool.Executables()
+	set := make(map[thor.Bytes32]bool, len(executables))
+	for _, tx := range executables {
+		set[tx.ID()] = true
+	}
+	return set
+}
+
+func (p *Pool) getStatus() (*Status, error) {
+	var (
+		executableSet = p.executableSet()
+		options       = p.pool.Options()
+		origins       = make(map[thor.Address]*OriginStatus)
+		status        = &Status{
+			Limit:           options.Limit,
+			LimitPerAccount: options.LimitPerAccount,
+			Origins:         []*OriginStatus{},
+		}
+	)
+
+	for _, tx := range p.pool.Dump() {
+		origin, err := tx.Origin()
+		if err != nil {
+			return nil, err
+		}
+		s, ok := origins[origin]
+		if !ok {
+			s = &OriginStatus{Origin: origin}
+			origins[origin] = s
+			status.Origins = append(status.Origins, s)
+		}
+		status.Total++
+		s.Total++
+		if executableSet[tx.ID()] {
+			status.Executable++
+			s.Executable++
+		}
+	}
+	status.NonExecutable = status.Total - status.Executable
+
+	// most active origins first
+	sort.Slice(status.Origins, func(i, j int) bool {
+		oi, oj := status.Origins[i], status.Origins[j]
+		if oi.Total != oj.Total {
+			return oi.Total > oj.Total
+		}
+		return bytes.Compare(oi.Origin[:], oj.Origin[:]) < 0
+	})
+	return status, nil
+}
+
+func (p *Pool) getPendingTxs(origin *thor.Address) ([]*PendingTx, error) {
+	best := p.repo.BestBlock().Header()
+	baseGasPrice, err := builtin.Params.Native(p.stater.NewState(best.StateRoot())).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		chain         = p.repo.NewChain(best.ID())
+		executableSet = p.executableSet()
+		results       = []*PendingTx{}
+	)
+	for _, tx := range p.pool.Dump() {
+		txOrigin, err := tx.Origin()
+		if err != nil {
+			return nil, err
+		}
+		if origin != nil && txOrigin != *origin {
+			continue
+		}
+
+		// proved work can't be measured if the block ref is in future
+		var overallGasPrice *big.Int
+		if provedWork, err := tx.ProvedWork(best.Number(), chain.GetBlockID); err == nil {
+			overallGasPrice = tx.OverallGasPrice(baseGasPrice, provedWork)
+		}
+		results = append(results, convertPendingTx(tx, txOrigin, executableSet[tx.ID()], overallGasPrice))
+	}
+
+	// same order as the pool offers to the packer
+	sort.SliceStable(results, func(i, j int) bool {
+		ri, rj := results[i], results[j]
+		if ri.Executable != rj.Executable {
+			return ri.Executable
+		}
+		if pi, pj := ri.OverallGasPrice, rj.OverallGasPrice; pi != nil && pj != nil {
+			if c := (*big.Int)(pi).Cmp((*big.Int)(pj)); c != 0 {
+				return c > 0
+			}
+		} else if pi != pj {
+			return pi != nil
+		}
+		return bytes.Compare(ri.ID[:], rj.ID[:]) < 0
+	})
+	return results, nil
+}
+
+func (p *Pool) why(id thor.Bytes32) *Why {
+	reason, ok := p.pool.Why(id)
+	if !ok {
+		if e := p.pool.Evicted(id); e != nil {
+			return &Why{
+				ID:         id,
+				Reason:     e.Reason,
+				Evicted:    true,
+				EvictedAt:  uint64(e.Time),
+				ReplacedBy: e.ReplacedBy,
+			}
+		}
+		return nil
+	}
+	return &Why{
+		ID:         id,
+		Executable: reason == "",
+		Reason:     reason,
+	}
+}
+
+func (p *Pool) handleGetStatus(w http.ResponseWriter, req *http.Request) error {
+	status, err := p.getStatus()
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, status)
+}
+
+func (p *Pool) handleGetTxs(w http.ResponseWriter, req *http.Request) error {
+	var origin *thor.Address
+	if s := req.URL.Query().Get("origin"); s != "" {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "origin"))
+		}
+		origin = &addr
+	}
+	txs, err := p.getPendingTxs(origin)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, txs)
+}
+
+func (p *Pool) handleGetWhy(w http.ResponseWriter, req *http.Request) error {
+	id, err := thor.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	return utils.WriteJSON(w, p.why(id))
+}
+
+func (p *Pool) handleGetBlocklist(w http.ResponseWriter, req *http.Request) error {
+	return utils.WriteJSON(w, convertBlocklistStatus(p.pool.BlocklistStatus()))
+}
+
+func (p *Pool) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/status").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetStatus))
+	sub.Path("/txs").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetTxs))
+	sub.Path("/txs/{id}/why").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetWhy))
+	sub.Path("/blocklist").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetBlocklist))
+}
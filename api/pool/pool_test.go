@@ -0,0 +1,228 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/pool"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+var ts *httptest.Server
+var txPool *txpool.TxPool
+var executableTx, pendingTx *tx.Transaction
+
+func TestPool(t *testing.T) {
+	initPoolServer(t)
+	defer ts.Close()
+	defer txPool.Close()
+
+	getStatus(t)
+	getTxs(t)
+	getWhy(t)
+	getWhyReplaced(t)
+	getBlocklist(t)
+}
+
+func getStatus(t *testing.T) {
+	var status *pool.Status
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/status"), &status); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, status.Total)
+	assert.Equal(t, 1, status.Executable)
+	assert.Equal(t, 1, status.NonExecutable)
+	assert.Equal(t, 16, status.LimitPerAccount)
+	assert.Equal(t, 2, len(status.Origins))
+	for _, s := range status.Origins {
+		assert.Equal(t, 1, s.Total)
+		if s.Origin == genesis.DevAccounts()[0].Address {
+			assert.Equal(t, 1, s.Executable)
+		} else {
+			assert.Equal(t, 0, s.Executable)
+		}
+	}
+}
+
+func getTxs(t *testing.T) {
+	var txs []*pool.PendingTx
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/txs"), &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, executableTx.ID(), txs[0].ID)
+	assert.True(t, txs[0].Executable)
+	assert.NotNil(t, txs[0].OverallGasPrice)
+	assert.Equal(t, pendingTx.ID(), txs[1].ID)
+	assert.False(t, txs[1].Executable)
+
+	txs = nil
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/txs?origin="+genesis.DevAccounts()[1].Address.String()), &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, pendingTx.ID(), txs[0].ID)
+	assert.Equal(t, genesis.DevAccounts()[1].Address, txs[0].Origin)
+
+	res, err := http.Get(ts.URL + "/txpool/txs?origin=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func getWhy(t *testing.T) {
+	var why *pool.Why
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/txs/"+pendingTx.ID().String()+"/why"), &why); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, why.Executable)
+	assert.Equal(t, "future block ref", why.Reason)
+
+	why = nil
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/txs/"+executableTx.ID().String()+"/why"), &why); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, why.Executable)
+	assert.Equal(t, "", why.Reason)
+
+	assert.Equal(t, "null\n", string(httpGet(t, ts.URL+"/txpool/txs/"+thor.Bytes32{}.String()+"/why")))
+}
+
+func getWhyReplaced(t *testing.T) {
+	// cancel the pending tx with a higher priced one of the same nonce
+	trx := new(tx.Builder).
+		ChainTag(pendingTx.ChainTag()).
+		Expiration(100).
+		GasPriceCoef(128).
+		Gas(21000).
+		BlockRef(tx.NewBlockRef(100)).
+		Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[1].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelTx := trx.WithSignature(sig)
+	if err := txPool.Add(cancelTx); err != nil {
+		t.Fatal(err)
+	}
+
+	var why *pool.Why
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/txs/"+pendingTx.ID().String()+"/why"), &why); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, why.Executable)
+	assert.Equal(t, "cancelled", why.Reason)
+	assert.True(t, why.Evicted)
+	assert.Equal(t, cancelTx.ID(), *why.ReplacedBy)
+}
+
+func getBlocklist(t *testing.T) {
+	var status *pool.BlocklistStatus
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/txpool/blocklist"), &status); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &pool.BlocklistStatus{Sources: []*pool.BlocklistSource{}}, status)
+}
+
+func newTx(t *testing.T, chainTag byte, blockRef tx.BlockRef, acc genesis.DevAccount) *tx.Transaction {
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(100).
+		Gas(21000).
+		Clause(tx.NewClause(&to)).
+		BlockRef(blockRef).
+		Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trx.WithSignature(sig)
+}
+
+func initPoolServer(t *testing.T) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	gene := genesis.NewDevnet()
+
+	b, _, _, err := gene.Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+
+	// pack a fresh block to have the chain synced, otherwise the pool won't wash
+	packer := packer.New(repo, stater, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address, thor.NoFork)
+	flow, err := packer.Schedule(b.Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.AddBlock(b, receipts); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetBestBlockID(b.Header().ID()); err != nil {
+		t.Fatal(err)
+	}
+
+	txPool = txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+	executableTx = newTx(t, repo.ChainTag(), tx.NewBlockRef(0), genesis.DevAccounts()[0])
+	pendingTx = newTx(t, repo.ChainTag(), tx.NewBlockRef(100), genesis.DevAccounts()[1])
+	if err := txPool.Add(executableTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := txPool.Add(pendingTx); err != nil {
+		t.Fatal(err)
+	}
+	// wait for the pool's housekeeping
+	for i := 0; len(txPool.Executables()) == 0; i++ {
+		if i > 50 {
+			t.Fatal("tx not executable")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	router := mux.NewRouter()
+	pool.New(repo, stater, txPool).Mount(router, "/txpool")
+	ts = httptest.NewServer(router)
+}
+
+func httpGet(t *testing.T, url string) []byte {
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
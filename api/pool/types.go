@@ -0,0 +1,112 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+// Status summarizes the tx pool.
+type Status struct {
+	Total           int             `json:"total"`
+	Executable      int             `json:"executable"`
+	NonExecutable   int             `json:"nonExecutable"`
+	Limit           int             `json:"limit"`
+	LimitPerAccount int             `json:"limitPerAccount"`
+	Origins         []*OriginStatus `json:"origins"`
+}
+
+// OriginStatus counts pending txs of an origin.
+type OriginStatus struct {
+	Origin     thor.Address `json:"origin"`
+	Total      int          `json:"total"`
+	Executable int          `json:"executable"`
+}
+
+// PendingTx describes a tx in the pool.
+type PendingTx struct {
+	ID              thor.Bytes32          `json:"id"`
+	Origin          thor.Address          `json:"origin"`
+	Delegator       *thor.Address         `json:"delegator"`
+	BlockRef        string                `json:"blockRef"`
+	Expiration      uint32                `json:"expiration"`
+	GasPriceCoef    uint8                 `json:"gasPriceCoef"`
+	Gas             uint64                `json:"gas"`
+	Nonce           math.HexOrDecimal64   `json:"nonce"`
+	DependsOn       *thor.Bytes32         `json:"dependsOn"`
+	Executable      bool                  `json:"executable"`
+	OverallGasPrice *math.HexOrDecimal256 `json:"overallGasPrice"`
+}
+
+func convertPendingTx(tx *tx.Transaction, origin thor.Address, executable bool, overallGasPrice *big.Int) *PendingTx {
+	delegator, _ := tx.Delegator()
+	br := tx.BlockRef()
+	return &PendingTx{
+		ID:              tx.ID(),
+		Origin:          origin,
+		Delegator:       delegator,
+		BlockRef:        hexutil.Encode(br[:]),
+		Expiration:      tx.Expiration(),
+		GasPriceCoef:    tx.GasPriceCoef(),
+		Gas:             tx.Gas(),
+		Nonce:           math.HexOrDecimal64(tx.Nonce()),
+		DependsOn:       tx.DependsOn(),
+		Executable:      executable,
+		OverallGasPrice: (*math.HexOrDecimal256)(overallGasPrice),
+	}
+}
+
+// Why explains the executable state of a pending tx, or why it's evicted from the pool.
+type Why struct {
+	ID         thor.Bytes32  `json:"id"`
+	Executable bool          `json:"executable"`
+	Reason     string        `json:"reason"`
+	Evicted    bool          `json:"evicted"`
+	EvictedAt  uint64        `json:"evictedAt,omitempty"`  // unix timestamp
+	ReplacedBy *thor.Bytes32 `json:"replacedBy,omitempty"` // ID of the tx replaced this one
+}
+
+// BlocklistStatus describes the blocklist of the pool.
+type BlocklistStatus struct {
+	Entries   int                `json:"entries"`
+	Cached    int                `json:"cached"`
+	Allowlist int                `json:"allowlist"`
+	Verified  bool               `json:"verified"`
+	Sources   []*BlocklistSource `json:"sources"`
+}
+
+// BlocklistSource describes a remote source of the blocklist.
+type BlocklistSource struct {
+	URL         string `json:"url"`
+	Entries     int    `json:"entries"`
+	LastUpdated uint64 `json:"lastUpdated"` // unix timestamp, 0 if never updated
+	LastError   string `json:"lastError,omitempty"`
+}
+
+func convertBlocklistStatus(status *txpool.BlocklistStatus) *BlocklistStatus {
+	result := &BlocklistStatus{
+		Entries:   status.Entries,
+		Cached:    status.Cached,
+		Allowlist: status.Allowlist,
+		Verified:  status.Verified,
+		Sources:   make([]*BlocklistSource, 0, len(status.Sources)),
+	}
+	for _, src := range status.Sources {
+		result.Sources = append(result.Sources, &BlocklistSource{
+			URL:         src.URL,
+			Entries:     src.Entries,
+			LastUpdated: uint64(src.LastUpdated),
+			LastError:   src.LastError,
+		})
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package reverted
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/logdb"
+)
+
+// response header carries the cursor of the last returned attempt
+const nextCursorHeader = "x-thorest-next"
+
+// Reverted serves attempts of reverted txs, which are recorded only if the node opted in.
+type Reverted struct {
+	repo *chain.Repository
+	db   logdb.LogDB
+}
+
+func New(repo *chain.Repository, db logdb.LogDB) *Reverted {
+	return &Reverted{
+		repo,
+		db,
+	}
+}
+
+func (r *Reverted) filter(ctx context.Context, filter *RevertedFilter) ([]*FilteredReverted, *uint64, error) {
+	rng, trng := events.ConvertRange(filter.Range, filter.TimeRange)
+	if err := events.CheckRetained(r.repo, r.db, rng, trng); err != nil {
+		return nil, nil, err
+	}
+
+	all, err := r.db.FilterReverted(ctx, &logdb.RevertedFilter{
+		CriteriaSet: filter.CriteriaSet,
+		Range:       rng,
+		TimeRange:   trng,
+		Cursor:      (*uint64)(filter.Cursor),
+		Options:     filter.Options,
+		Order:       filter.Order,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	filtered := make([]*FilteredReverted, len(all))
+	for i, reverted := range all {
+		filtered[i] = convertReverted(reverted)
+	}
+	if len(all) == 0 {
+		return filtered, nil, nil
+	}
+	next := all[len(all)-1].Cursor()
+	return filtered, &next, nil
+}
+
+func (r *Reverted) handleFilterReverted(w http.ResponseWriter, req *http.Request) error {
+	var filter RevertedFilter
+	if err := utils.ParseJSON(req.Body, &filter); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	filtered, next, err := r.filter(req.Context(), &filter)
+	if err != nil {
+		return err
+	}
+	if next != nil {
+		w.Header().Set(nextCursorHeader, hexutil.EncodeUint64(*next))
+	}
+	return utils.WriteJSON(w, filtered)
+}
+
+func (r *Reverted) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(r.handleFilterReverted))
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package reverted_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/api/reverted"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var (
+	ts     *httptest.Server
+	logDB  logdb.LogDB
+	target = thor.BytesToAddress([]byte("target"))
+	blocks []*block.Block
+)
+
+func TestReverted(t *testing.T) {
+	initRevertedServer(t)
+	defer ts.Close()
+
+	filterReverted(t)
+	filterPaged(t)
+	filterPruned(t)
+}
+
+func filterReverted(t *testing.T) {
+	// 2 clauses of the reverted tx in block 1, and 1 in block 2
+	all, _, statusCode := filter(t, &reverted.RevertedFilter{})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 3, len(all))
+
+	revertedTx := blocks[1].Transactions()[0]
+	origin, _ := revertedTx.Origin()
+	assert.Equal(t, &target, all[0].Target)
+	assert.Equal(t, (*math.HexOrDecimal256)(big.NewInt(1)), all[0].Amount)
+	assert.Equal(t, events.LogMeta{
+		BlockID:        blocks[1].Header().ID(),
+		BlockNumber:    blocks[1].Header().Number(),
+		BlockTimestamp: blocks[1].Header().Timestamp(),
+		TxID:           revertedTx.ID(),
+		TxOrigin:       origin,
+		ClauseIndex:    0,
+	}, all[0].Meta)
+	assert.Nil(t, all[1].Target, "contract creation")
+	assert.Equal(t, uint32(1), all[1].Meta.ClauseIndex)
+
+	got, _, statusCode := filter(t, &reverted.RevertedFilter{
+		CriteriaSet: []*logdb.RevertedCriteria{{Target: &target}},
+		Order:       logdb.DESC,
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, blocks[2].Header().ID(), got[0].Meta.BlockID)
+	assert.Equal(t, blocks[1].Header().ID(), got[1].Meta.BlockID)
+
+	got, _, statusCode = filter(t, &reverted.RevertedFilter{
+		Range: &events.Range{Unit: events.BlockRangeType, From: 2, To: 2},
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []*reverted.FilteredReverted{all[2]}, got)
+
+	res, err := http.Post(ts.URL+"/logs/reverted", "application/json", bytes.NewReader([]byte("{")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func filterPaged(t *testing.T) {
+	for _, order := range []logdb.Order{logdb.ASC, logdb.DESC} {
+		all, _, _ := filter(t, &reverted.RevertedFilter{Order: order})
+
+		var (
+			paged  []*reverted.FilteredReverted
+			cursor *math.HexOrDecimal64
+		)
+		for {
+			got, next, statusCode := filter(t, &reverted.RevertedFilter{
+				Cursor:  cursor,
+				Options: &logdb.Options{Limit: 2},
+				Order:   order,
+			})
+			assert.Equal(t, http.StatusOK, statusCode)
+			if len(got) == 0 {
+				assert.Equal(t, "", next, "no cursor if exhausted")
+				break
+			}
+			paged = append(paged, got...)
+
+			n, err := hexutil.DecodeUint64(next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cursor = (*math.HexOrDecimal64)(&n)
+		}
+		// neither repeated nor skipped
+		assert.Equal(t, all, paged, order)
+	}
+}
+
+func filterPruned(t *testing.T) {
+	if err := logDB.Prune(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	_, _, statusCode := filter(t, &reverted.RevertedFilter{
+		Range: &events.Range{Unit: events.BlockRangeType, From: 1, To: 2},
+	})
+	assert.Equal(t, http.StatusGone, statusCode)
+
+	got, _, statusCode := filter(t, &reverted.RevertedFilter{
+		Range: &events.Range{Unit: events.BlockRangeType, From: 2, To: 2},
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 1, len(got))
+}
+
+func initRevertedServer(t *testing.T) {
+	db := muxdb.NewMem()
+	gene := genesis.NewDevnet()
+	b, _, _, err := gene.Build(state.NewStater(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, _ := chain.NewRepository(db, b)
+
+	if logDB, err = logdb.NewMem(); err != nil {
+		t.Fatal(err)
+	}
+	logDB.RecordReverted(true)
+
+	blocks = []*block.Block{b}
+	b1 := new(block.Builder).
+		ParentID(b.Header().ID()).
+		Transaction(newTx(t, 0, tx.NewClause(&target).WithValue(big.NewInt(1)), tx.NewClause(nil))).
+		Build()
+	b2 := new(block.Builder).
+		ParentID(b1.Header().ID()).
+		Transaction(newTx(t, 1)).
+		Transaction(newTx(t, 2, tx.NewClause(&target).WithValue(big.NewInt(2)))).
+		Build()
+	blocks = append(blocks, b1, b2)
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		if err := w.Write(b1, tx.Receipts{{Reverted: true}}); err != nil {
+			return err
+		}
+		return w.Write(b2, tx.Receipts{{}, {Reverted: true}})
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	router := mux.NewRouter()
+	reverted.New(repo, logDB).Mount(router, "/logs/reverted")
+	ts = httptest.NewServer(router)
+}
+
+func newTx(t *testing.T, nonce uint64, clauses ...*tx.Clause) *tx.Transaction {
+	builder := new(tx.Builder).Nonce(nonce)
+	for _, c := range clauses {
+		builder.Clause(c)
+	}
+	trx := builder.Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trx.WithSignature(sig)
+}
+
+// filter posts the filter, and returns the attempts, the next cursor header and the status code.
+func filter(t *testing.T, f *reverted.RevertedFilter) ([]*reverted.FilteredReverted, string, int) {
+	body, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Post(ts.URL+"/logs/reverted", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, "", res.StatusCode
+	}
+	var got []*reverted.FilteredReverted
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	return got, res.Header.Get("x-thorest-next"), res.StatusCode
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package reverted
+
+import (
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+)
+
+// FilteredReverted is the attempt of a clause of the reverted tx.
+type FilteredReverted struct {
+	Target *thor.Address         `json:"target"` // null for contract creation
+	Amount *math.HexOrDecimal256 `json:"amount"`
+	Meta   events.LogMeta        `json:"meta"`
+}
+
+func convertReverted(reverted *logdb.Reverted) *FilteredReverted {
+	v := math.HexOrDecimal256(*reverted.Amount)
+	return &FilteredReverted{
+		Target: reverted.Target,
+		Amount: &v,
+		Meta: events.LogMeta{
+			BlockID:        reverted.BlockID,
+			BlockNumber:    reverted.BlockNumber,
+			BlockTimestamp: reverted.BlockTime,
+			TxID:           reverted.TxID,
+			TxOrigin:       reverted.TxOrigin,
+			ClauseIndex:    reverted.ClauseIndex,
+		},
+	}
+}
+
+type RevertedFilter struct {
+	CriteriaSet []*logdb.RevertedCriteria
+	Range       *events.Range
+	TimeRange   *events.TimeRange
+	Cursor      *math.HexOrDecimal64
+	Options     *logdb.Options
+	Order       logdb.Order //default asc
+}
@@ -6,6 +6,8 @@
 package subscriptions
 
 import (
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
 )
@@ -13,13 +15,15 @@ import (
 type eventReader struct {
 	repo        *chain.Repository
 	filter      *EventFilter
+	eventABI    *abi.Event // optional, to decode matched events
 	blockReader chain.BlockReader
 }
 
-func newEventReader(repo *chain.Repository, position thor.Bytes32, filter *EventFilter) *eventReader {
+func newEventReader(repo *chain.Repository, position thor.Bytes32, filter *EventFilter, eventABI *abi.Event) *eventReader {
 	return &eventReader{
 		repo:        repo,
 		filter:      filter,
+		eventABI:    eventABI,
 		blockReader: repo.NewBlockReader(position),
 	}
 }
@@ -44,6 +48,10 @@ func (er *eventReader) Read() ([]interface{}, bool, error) {
 						if err != nil {
 							return nil, false, err
 						}
+						if er.eventABI != nil {
+							// left undecoded if it doesn't fit the ABI
+							msg.Decoded, _ = events.DecodeEvent(er.eventABI, event.Topics, event.Data)
+						}
 						msgs = append(msgs, msg)
 					}
 				}
@@ -0,0 +1,77 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/vechain/thor/txpool"
+)
+
+// size of buffered tx events, the subscriber is dropped if exceeded
+const pendingTxBufferSize = 1024
+
+// pendingTxReader reads tx events pushed by the tx pool.
+// Unlike readers of chain data, it's not polled on new blocks.
+type pendingTxReader struct {
+	txCh     chan *txpool.TxEvent
+	overflow chan struct{} // closed when events are dropped since the buffer is full
+	sub      event.Subscription
+	filter   *PendingTxFilter
+	expanded bool
+}
+
+func newPendingTxReader(pool *txpool.TxPool, filter *PendingTxFilter, expanded bool) *pendingTxReader {
+	feedCh := make(chan *txpool.TxEvent)
+	pr := &pendingTxReader{
+		txCh:     make(chan *txpool.TxEvent, pendingTxBufferSize),
+		overflow: make(chan struct{}),
+		sub:      pool.SubscribeTxEvent(feedCh),
+		filter:   filter,
+		expanded: expanded,
+	}
+	go pr.forward(feedCh)
+	return pr
+}
+
+// forward moves events from the pool's feed into the buffer.
+// The feed blocks until all subscribers received, so once the buffer of a slow subscriber is full,
+// events are dropped rather than stalling the pool, and the subscriber is notified to disconnect.
+func (pr *pendingTxReader) forward(feedCh <-chan *txpool.TxEvent) {
+	overflowed := false
+	for {
+		select {
+		case ev := <-feedCh:
+			if overflowed {
+				continue
+			}
+			select {
+			case pr.txCh <- ev:
+			default:
+				overflowed = true
+				close(pr.overflow)
+			}
+		case <-pr.sub.Err():
+			// unsubscribed or the pool closed
+			return
+		}
+	}
+}
+
+// Read converts the tx event into message. Nil message returned if the tx is filtered out.
+func (pr *pendingTxReader) Read(ev *txpool.TxEvent) (interface{}, error) {
+	origin, err := ev.Tx.Origin()
+	if err != nil {
+		return nil, err
+	}
+	if !pr.filter.Match(ev.Tx, origin) {
+		return nil, nil
+	}
+	return convertPendingTx(ev, origin, pr.expanded), nil
+}
+
+func (pr *pendingTxReader) Close() {
+	pr.sub.Unsubscribe()
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+// newTestPool creates a pool on top of the devnet genesis, which is not synced,
+// so that tx events are sent synchronously when txs added.
+func newTestPool(t *testing.T) (*chain.Repository, *txpool.TxPool) {
+	db := muxdb.NewMem()
+	stater := state.NewStater(db)
+	b, _, _, err := genesis.NewDevnet().Build(stater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := chain.NewRepository(db, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo, txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 10000, MaxLifetime: time.Hour})
+}
+
+func newTx(t *testing.T, chainTag byte, nonce uint64, to thor.Address, acc genesis.DevAccount) *tx.Transaction {
+	trx := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(100).
+		Gas(21000).
+		Nonce(nonce).
+		Clause(tx.NewClause(&to)).
+		Build()
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trx.WithSignature(sig)
+}
+
+func TestPendingTxReader(t *testing.T) {
+	repo, pool := newTestPool(t)
+	defer pool.Close()
+
+	var (
+		acc = genesis.DevAccounts()[0]
+		to  = thor.BytesToAddress([]byte("to"))
+	)
+	reader := newPendingTxReader(pool, &PendingTxFilter{To: &to}, false)
+	defer reader.Close()
+
+	other := newTx(t, repo.ChainTag(), 0, thor.BytesToAddress([]byte("other")), acc)
+	matched := newTx(t, repo.ChainTag(), 1, to, acc)
+	assert.Nil(t, pool.Add(other))
+	assert.Nil(t, pool.Add(matched))
+
+	msg, err := reader.Read(<-reader.txCh)
+	assert.Nil(t, err)
+	assert.Nil(t, msg, "filtered out")
+
+	msg, err = reader.Read(<-reader.txCh)
+	assert.Nil(t, err)
+	assert.Equal(t, &PendingTxMessage{
+		ID:     matched.ID(),
+		Origin: acc.Address,
+		Status: string(txpool.TxStatusAdded),
+	}, msg)
+}
+
+func TestPendingTxReaderOverflow(t *testing.T) {
+	repo, pool := newTestPool(t)
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	reader := newPendingTxReader(pool, &PendingTxFilter{}, false)
+	defer reader.Close()
+
+	txs := make([]*tx.Transaction, pendingTxBufferSize+10)
+	for i := range txs {
+		txs[i] = newTx(t, repo.ChainTag(), uint64(i), thor.Address{}, acc)
+	}
+
+	// the slow reader never reads, but the pool should not be blocked
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, trx := range txs {
+			assert.Nil(t, pool.Add(trx))
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("pool blocked by the slow reader")
+	}
+
+	select {
+	case <-reader.overflow:
+	default:
+		t.Fatal("overflow not notified")
+	}
+	assert.Equal(t, pendingTxBufferSize, len(reader.txCh))
+}
+
+func TestPendingTxSubject(t *testing.T) {
+	repo, pool := newTestPool(t)
+	defer pool.Close()
+
+	subs := New(repo, nil, 10, pool)
+	router := mux.NewRouter()
+	subs.Mount(router, "/subscriptions")
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+	defer subs.Close()
+
+	acc := genesis.DevAccounts()[0]
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(ts.URL, "http", "ws", 1)+"/subscriptions/txpool?origin="+acc.Address.String(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// subscribed before the websocket handshake completed
+	trx := newTx(t, repo.ChainTag(), 0, thor.Address{}, acc)
+	assert.Nil(t, pool.Add(trx))
+
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	var msg PendingTxMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, trx.ID(), msg.ID)
+	assert.Equal(t, acc.Address, msg.Origin)
+}
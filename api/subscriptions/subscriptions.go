@@ -6,22 +6,28 @@
 package subscriptions
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
 )
 
 type Subscriptions struct {
 	backtraceLimit uint32
 	repo           *chain.Repository
+	txPool         *txpool.TxPool
 	upgrader       *websocket.Upgrader
 	done           chan struct{}
 	wg             sync.WaitGroup
@@ -31,14 +37,18 @@ type msgReader interface {
 	Read() (msgs []interface{}, hasMore bool, err error)
 }
 
+// max count of addresses and topics of an event subscription
+const maxFilterValues = 100
+
 var (
 	log = log15.New("pkg", "subscriptions")
 )
 
-func New(repo *chain.Repository, allowedOrigins []string, backtraceLimit uint32) *Subscriptions {
+func New(repo *chain.Repository, allowedOrigins []string, backtraceLimit uint32, txPool *txpool.TxPool) *Subscriptions {
 	return &Subscriptions{
 		backtraceLimit: backtraceLimit,
 		repo:           repo,
+		txPool:         txPool,
 		upgrader: &websocket.Upgrader{
 			EnableCompression: true,
 			CheckOrigin: func(r *http.Request) bool {
@@ -71,39 +81,52 @@ func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Reque
 	if err != nil {
 		return nil, err
 	}
-	address, err := parseAddress(req.URL.Query().Get("addr"))
+	addresses, err := parseAddresses(req.URL.Query().Get("addr"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "addr"))
 	}
-	t0, err := parseTopic(req.URL.Query().Get("t0"))
+	t0, err := parseTopics(req.URL.Query().Get("t0"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "t0"))
 	}
-	t1, err := parseTopic(req.URL.Query().Get("t1"))
+	t1, err := parseTopics(req.URL.Query().Get("t1"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "t1"))
 	}
-	t2, err := parseTopic(req.URL.Query().Get("t2"))
+	t2, err := parseTopics(req.URL.Query().Get("t2"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "t2"))
 	}
-	t3, err := parseTopic(req.URL.Query().Get("t3"))
+	t3, err := parseTopics(req.URL.Query().Get("t3"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "t3"))
 	}
-	t4, err := parseTopic(req.URL.Query().Get("t4"))
+	t4, err := parseTopics(req.URL.Query().Get("t4"))
 	if err != nil {
 		return nil, utils.BadRequest(errors.WithMessage(err, "t4"))
 	}
+	var eventABI *abi.Event
+	if abiStr := req.URL.Query().Get("abi"); abiStr != "" {
+		if eventABI, err = events.ParseEventABI([]byte(abiStr)); err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "abi"))
+		}
+		if len(t0) == 0 && !eventABI.Anonymous() {
+			// match only the event described by the ABI
+			t0 = []thor.Bytes32{eventABI.ID()}
+		}
+	}
+	if len(addresses)+len(t0)+len(t1)+len(t2)+len(t3)+len(t4) > maxFilterValues {
+		return nil, utils.BadRequest(fmt.Errorf("too many addresses and topics, max %d", maxFilterValues))
+	}
 	eventFilter := &EventFilter{
-		Address: address,
+		Address: addresses,
 		Topic0:  t0,
 		Topic1:  t1,
 		Topic2:  t2,
 		Topic3:  t3,
 		Topic4:  t4,
 	}
-	return newEventReader(s.repo, position, eventFilter), nil
+	return newEventReader(s.repo, position, eventFilter, eventABI), nil
 }
 
 func (s *Subscriptions) handleTransferReader(w http.ResponseWriter, req *http.Request) (*transferReader, error) {
@@ -139,13 +162,34 @@ func (s *Subscriptions) handleBeatReader(w http.ResponseWriter, req *http.Reques
 	return newBeatReader(s.repo, position), nil
 }
 
+func (s *Subscriptions) handlePendingTxReader(w http.ResponseWriter, req *http.Request) (*pendingTxReader, error) {
+	origin, err := parseAddress(req.URL.Query().Get("origin"))
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "origin"))
+	}
+	to, err := parseAddress(req.URL.Query().Get("to"))
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "to"))
+	}
+	expanded := req.URL.Query().Get("expanded")
+	if expanded != "" && expanded != "false" && expanded != "true" {
+		return nil, utils.BadRequest(errors.WithMessage(errors.New("should be boolean"), "expanded"))
+	}
+	pendingTxFilter := &PendingTxFilter{
+		Origin: origin,
+		To:     to,
+	}
+	return newPendingTxReader(s.txPool, pendingTxFilter, expanded == "true"), nil
+}
+
 func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request) error {
 	s.wg.Add(1)
 	defer s.wg.Done()
 
 	var (
-		reader msgReader
-		err    error
+		reader    msgReader
+		pendingTx *pendingTxReader
+		err       error
 	)
 	switch mux.Vars(req)["subject"] {
 	case "block":
@@ -164,6 +208,11 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		if reader, err = s.handleBeatReader(w, req); err != nil {
 			return err
 		}
+	case "txpool":
+		if pendingTx, err = s.handlePendingTxReader(w, req); err != nil {
+			return err
+		}
+		defer pendingTx.Close()
 	default:
 		return utils.HTTPError(errors.New("not found"), http.StatusNotFound)
 	}
@@ -181,8 +230,14 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		}
 	}()
 
+	if pendingTx != nil {
+		err = s.pipePendingTx(conn, pendingTx)
+	} else {
+		err = s.pipe(conn, reader)
+	}
+
 	var closeMsg []byte
-	if err := s.pipe(conn, reader); err != nil {
+	if err != nil {
 		closeMsg = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error())
 	} else {
 		closeMsg = websocket.FormatCloseMessage(websocket.CloseGoingAway, "")
@@ -194,9 +249,10 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 	return nil
 }
 
-func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
+// readLoop starts read loop to handle close event.
+// The returned channel will be closed when the conn is closed.
+func (s *Subscriptions) readLoop(conn *websocket.Conn) <-chan struct{} {
 	closed := make(chan struct{})
-	// start read loop to handle close event
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -208,6 +264,11 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 			}
 		}
 	}()
+	return closed
+}
+
+func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
+	closed := s.readLoop(conn)
 	ticker := s.repo.NewTicker()
 	for {
 		msgs, hasMore, err := reader.Read()
@@ -239,6 +300,33 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 	}
 }
 
+func (s *Subscriptions) pipePendingTx(conn *websocket.Conn, reader *pendingTxReader) error {
+	closed := s.readLoop(conn)
+	for {
+		select {
+		case <-s.done:
+			return nil
+		case <-closed:
+			return nil
+		case err := <-reader.sub.Err():
+			// nil error if the pool is closed
+			return err
+		case <-reader.overflow:
+			return errors.New("too slow to receive pending txs")
+		case ev := <-reader.txCh:
+			msg, err := reader.Read(ev)
+			if err != nil {
+				return err
+			}
+			if msg != nil {
+				if err := conn.WriteJSON(msg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
 func (s *Subscriptions) parsePosition(posStr string) (thor.Bytes32, error) {
 	bestID := s.repo.BestBlock().Header().ID()
 	if posStr == "" {
@@ -254,15 +342,36 @@ func (s *Subscriptions) parsePosition(posStr string) (thor.Bytes32, error) {
 	return pos, nil
 }
 
-func parseTopic(t string) (*thor.Bytes32, error) {
-	if t == "" {
+// parseTopics parses the comma separated list of topics.
+func parseTopics(list string) ([]thor.Bytes32, error) {
+	if list == "" {
 		return nil, nil
 	}
-	topic, err := thor.ParseBytes32(t)
-	if err != nil {
-		return nil, err
+	var topics []thor.Bytes32
+	for _, t := range strings.Split(list, ",") {
+		topic, err := thor.ParseBytes32(strings.TrimSpace(t))
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+// parseAddresses parses the comma separated list of addresses.
+func parseAddresses(list string) ([]thor.Address, error) {
+	if list == "" {
+		return nil, nil
+	}
+	var addresses []thor.Address
+	for _, addr := range strings.Split(list, ",") {
+		address, err := thor.ParseAddress(strings.TrimSpace(addr))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
 	}
-	return &topic, nil
+	return addresses, nil
 }
 
 func parseAddress(addr string) (*thor.Address, error) {
@@ -8,10 +8,13 @@ package subscriptions
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/api/events"
+	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
 )
 
 //BlockMessage block piped by websocket
@@ -108,11 +111,12 @@ func convertTransfer(header *block.Header, tx *tx.Transaction, clauseIndex uint3
 
 //EventMessage event piped by websocket
 type EventMessage struct {
-	Address  thor.Address   `json:"address"`
-	Topics   []thor.Bytes32 `json:"topics"`
-	Data     string         `json:"data"`
-	Meta     LogMeta        `json:"meta"`
-	Obsolete bool           `json:"obsolete"`
+	Address  thor.Address         `json:"address"`
+	Topics   []thor.Bytes32       `json:"topics"`
+	Data     string               `json:"data"`
+	Meta     LogMeta              `json:"meta"`
+	Obsolete bool                 `json:"obsolete"`
+	Decoded  *events.DecodedEvent `json:"decoded,omitempty"`
 }
 
 func convertEvent(header *block.Header, tx *tx.Transaction, clauseIndex uint32, event *tx.Event, obsolete bool) (*EventMessage, error) {
@@ -137,32 +141,44 @@ func convertEvent(header *block.Header, tx *tx.Transaction, clauseIndex uint32,
 }
 
 // EventFilter contains options for contract event filtering.
+// An event matches if it matches any of the values of each non-empty field.
 type EventFilter struct {
-	Address *thor.Address // restricts matches to events created by specific contracts
-	Topic0  *thor.Bytes32
-	Topic1  *thor.Bytes32
-	Topic2  *thor.Bytes32
-	Topic3  *thor.Bytes32
-	Topic4  *thor.Bytes32
+	Address []thor.Address // restricts matches to events created by specific contracts
+	Topic0  []thor.Bytes32
+	Topic1  []thor.Bytes32
+	Topic2  []thor.Bytes32
+	Topic3  []thor.Bytes32
+	Topic4  []thor.Bytes32
 }
 
 // Match returs whether event matches filter
 func (ef *EventFilter) Match(event *tx.Event) bool {
-	if (ef.Address != nil) && (*ef.Address != event.Address) {
-		return false
-	}
-
-	matchTopic := func(topic *thor.Bytes32, index int) bool {
-		if topic != nil {
-			if len(event.Topics) <= index {
-				return false
+	if len(ef.Address) > 0 {
+		matched := false
+		for _, addr := range ef.Address {
+			if addr == event.Address {
+				matched = true
+				break
 			}
+		}
+		if !matched {
+			return false
+		}
+	}
 
-			if *topic != event.Topics[index] {
-				return false
+	matchTopic := func(topics []thor.Bytes32, index int) bool {
+		if len(topics) == 0 {
+			return true
+		}
+		if len(event.Topics) <= index {
+			return false
+		}
+		for _, topic := range topics {
+			if topic == event.Topics[index] {
+				return true
 			}
 		}
-		return true
+		return false
 	}
 
 	return matchTopic(ef.Topic0, 0) &&
@@ -195,6 +211,66 @@ func (tf *TransferFilter) Match(transfer *tx.Transfer, origin thor.Address) bool
 	return true
 }
 
+//PendingTxMessage pending tx piped by websocket
+type PendingTxMessage struct {
+	ID         thor.Bytes32              `json:"id"`
+	Origin     thor.Address              `json:"origin"`
+	Status     string                    `json:"status"`
+	Executable *bool                     `json:"executable"`       // nil if unknown, when chain is not synced, or tx removed
+	Reason     string                    `json:"reason,omitempty"` // reason of eviction
+	Replaced   *ReplacedTx               `json:"replaced,omitempty"`
+	Tx         *transactions.Transaction `json:"tx,omitempty"`
+}
+
+// ReplacedTx is the pooled tx replaced by the pending tx, which has the same origin and nonce.
+type ReplacedTx struct {
+	ID        thor.Bytes32 `json:"id"`
+	Cancelled bool         `json:"cancelled"` // whether the pending tx does nothing but cancels the replaced one
+}
+
+func convertPendingTx(ev *txpool.TxEvent, origin thor.Address, expanded bool) *PendingTxMessage {
+	msg := &PendingTxMessage{
+		ID:         ev.Tx.ID(),
+		Origin:     origin,
+		Status:     string(ev.Status),
+		Executable: ev.Executable,
+		Reason:     ev.Reason,
+	}
+	if ev.Replaced != nil {
+		msg.Replaced = &ReplacedTx{
+			ID:        ev.Replaced.TxID,
+			Cancelled: ev.Replaced.Cancelled,
+		}
+	}
+	if expanded {
+		msg.Tx = transactions.ConvertTransaction(ev.Tx, nil)
+	}
+	return msg
+}
+
+// PendingTxFilter contains options for pending tx filtering.
+type PendingTxFilter struct {
+	Origin *thor.Address // who send transaction
+	To     *thor.Address // target of any clause
+}
+
+// Match returs whether tx matches filter
+func (pf *PendingTxFilter) Match(tx *tx.Transaction, origin thor.Address) bool {
+	if (pf.Origin != nil) && (*pf.Origin != origin) {
+		return false
+	}
+
+	if pf.To != nil {
+		for _, c := range tx.Clauses() {
+			if to := c.To(); to != nil && *to == *pf.To {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
 type BeatMessage struct {
 	Number      uint32       `json:"number"`
 	ID          thor.Bytes32 `json:"id"`
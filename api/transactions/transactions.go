@@ -6,27 +6,44 @@
 package transactions
 
 import (
+	"context"
+	"math"
+	"math/big"
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	hexMath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
+	"github.com/vechain/thor/xenv"
 )
 
 type Transactions struct {
-	repo *chain.Repository
-	pool *txpool.TxPool
+	repo         *chain.Repository
+	stater       *state.Stater
+	pool         *txpool.TxPool
+	callGasLimit uint64
+	forkConfig   thor.ForkConfig
 }
 
-func New(repo *chain.Repository, pool *txpool.TxPool) *Transactions {
+func New(repo *chain.Repository, stater *state.Stater, pool *txpool.TxPool, callGasLimit uint64, forkConfig thor.ForkConfig) *Transactions {
 	return &Transactions{
 		repo,
+		stater,
 		pool,
+		callGasLimit,
+		forkConfig,
 	}
 }
 
@@ -75,7 +92,7 @@ func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32,
 		if t.repo.IsNotFound(err) {
 			if allowPending {
 				if pending := t.pool.Get(txID); pending != nil {
-					return convertTransaction(pending, nil), nil
+					return ConvertTransaction(pending, nil), nil
 				}
 			}
 			return nil, nil
@@ -87,11 +104,11 @@ func (t *Transactions) getTransactionByID(txID thor.Bytes32, head thor.Bytes32,
 	if err != nil {
 		return nil, err
 	}
-	return convertTransaction(tx, summary.Header), nil
+	return ConvertTransaction(tx, summary.Header), nil
 }
 
 //GetTransactionReceiptByID get tx's receipt
-func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.Bytes32) (*Receipt, error) {
+func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.Bytes32, withRevertReason bool) (*Receipt, error) {
 	chain := t.repo.NewChain(head)
 	tx, meta, err := chain.GetTransaction(txID)
 	if err != nil {
@@ -111,7 +128,56 @@ func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, head thor.By
 		return nil, err
 	}
 
-	return convertReceipt(receipt, summary.Header, tx)
+	jReceipt, err := convertReceipt(receipt, summary.Header, tx)
+	if err != nil {
+		return nil, err
+	}
+	if withRevertReason && receipt.Reverted {
+		output, err := t.replayRevertedTx(meta.BlockID, meta.Index)
+		if err != nil {
+			return nil, err
+		}
+		if output != nil {
+			jReceipt.VMError = output.VMErr.Error()
+			jReceipt.RevertData = hexutil.Encode(output.Data)
+			jReceipt.RevertReason, _ = abi.UnpackRevert(output.Data)
+		}
+	}
+	return jReceipt, nil
+}
+
+// replayRevertedTx re-executes txs in the block up to the reverted one, and returns output of the reverted clause.
+func (t *Transactions) replayRevertedTx(blockID thor.Bytes32, txIndex uint64) (*runtime.Output, error) {
+	block, err := t.repo.GetBlock(blockID)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := utils.NewReplayRuntime(t.repo, t.stater, t.forkConfig, block.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+	for _, tx := range txs[:txIndex] {
+		if _, err := rt.ExecuteTransaction(tx); err != nil {
+			return nil, err
+		}
+	}
+	txExec, err := rt.PrepareTransaction(txs[txIndex])
+	if err != nil {
+		return nil, err
+	}
+	for txExec.HasNextClause() {
+		_, output, err := txExec.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		if output.VMErr != nil {
+			return output, nil
+		}
+	}
+	// should not happen unless the replay diverges
+	return nil, nil
 }
 func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Request) error {
 	var rawTx *RawTx
@@ -194,13 +260,456 @@ func (t *Transactions) handleGetTransactionReceiptByID(w http.ResponseWriter, re
 		}
 	}
 
-	receipt, err := t.getTransactionReceiptByID(txID, head)
+	withRevertReason := req.URL.Query().Get("withRevertReason")
+	if withRevertReason != "" && withRevertReason != "false" && withRevertReason != "true" {
+		return utils.BadRequest(errors.WithMessage(errors.New("should be boolean"), "withRevertReason"))
+	}
+
+	receipt, err := t.getTransactionReceiptByID(txID, head, withRevertReason == "true")
 	if err != nil {
 		return err
 	}
 	return utils.WriteJSON(w, receipt)
 }
 
+// newRuntime creates the runtime to execute txs as if they are packed into a new block on top of parent.
+func (t *Transactions) newRuntime(parent *block.Header) (*runtime.Runtime, tx.Features, error) {
+	state := t.stater.NewState(parent.StateRoot())
+
+	// same as the before process hook of VIP-191 in packer
+	vip191 := t.forkConfig.VIP191
+	if vip191 == 0 {
+		vip191 = 1
+	}
+	if parent.Number()+1 == vip191 {
+		if err := state.SetCode(builtin.Extension.Address, builtin.Extension.V2.RuntimeBytecodes()); err != nil {
+			return nil, 0, err
+		}
+	}
+	var features tx.Features
+	if parent.Number()+1 >= vip191 {
+		features |= tx.DelegationFeature
+	}
+
+	rt := runtime.New(
+		t.repo.NewChain(parent.ID()),
+		state,
+		&xenv.BlockContext{
+			Number:     parent.Number() + 1,
+			Time:       parent.Timestamp() + thor.BlockInterval,
+			GasLimit:   parent.GasLimit(),
+			TotalScore: parent.TotalScore() + 1,
+		},
+		t.forkConfig)
+	return rt, features, nil
+}
+
+// resolveSimulateTx decodes or builds the tx to be simulated, and resolves its origin and delegator.
+func (t *Transactions) resolveSimulateTx(data *SimulateTxData) (trx *tx.Transaction, origin thor.Address, delegator *thor.Address, err error) {
+	if data.Raw != "" {
+		if data.hasBody() {
+			return nil, thor.Address{}, nil, utils.BadRequest(errors.New("body: raw and tx fields are exclusive"))
+		}
+		if trx, err = (&RawTx{data.Raw}).decode(); err != nil {
+			return nil, thor.Address{}, nil, utils.BadRequest(errors.WithMessage(err, "raw"))
+		}
+	} else {
+		if trx, err = data.build(); err != nil {
+			return nil, thor.Address{}, nil, utils.BadRequest(err)
+		}
+	}
+
+	if len(trx.Signature()) == 0 {
+		// unsigned tx
+		if data.Origin == nil {
+			return nil, thor.Address{}, nil, utils.BadRequest(errors.New("origin: required for unsigned tx"))
+		}
+		return trx, *data.Origin, data.Delegator, nil
+	}
+
+	if origin, err = trx.Origin(); err != nil {
+		return nil, thor.Address{}, nil, utils.BadRequest(errors.WithMessage(err, "raw"))
+	}
+	if delegator, err = trx.Delegator(); err != nil {
+		return nil, thor.Address{}, nil, utils.BadRequest(errors.WithMessage(err, "raw"))
+	}
+	if data.Origin != nil && *data.Origin != origin {
+		return nil, thor.Address{}, nil, utils.BadRequest(errors.New("origin: mismatch with signature"))
+	}
+	if data.Delegator != nil && (delegator == nil || *data.Delegator != *delegator) {
+		return nil, thor.Address{}, nil, utils.BadRequest(errors.New("delegator: mismatch with signature"))
+	}
+	return trx, origin, delegator, nil
+}
+
+// simulate executes the tx as if it's packed into a new block on top of the given parent.
+func (t *Transactions) simulate(ctx context.Context, data *SimulateTxData, parent *block.Header) (*SimulateResult, error) {
+	trx, origin, delegator, err := t.resolveSimulateTx(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SimulateResult{
+		Origin:    origin,
+		Delegator: delegator,
+		Results:   []*ClauseResult{},
+	}
+	if len(trx.Signature()) == 0 {
+		result.ID = trx.DelegatorSigningHash(origin)
+	} else {
+		result.ID = trx.ID()
+	}
+	result.IntrinsicGas, _ = trx.IntrinsicGas()
+
+	reject := func(reason string) (*SimulateResult, error) {
+		result.Rejected = true
+		result.RejectReason = reason
+		return result, nil
+	}
+
+	rt, features, err := t.newRuntime(parent)
+	if err != nil {
+		return nil, err
+	}
+	blockCtx := rt.Context()
+
+	// same checks as packer does when adopting tx
+	if blockCtx.Number >= t.forkConfig.BLOCKLIST && thor.IsOriginBlocked(origin) {
+		return reject("tx origin blocked")
+	}
+	if err := trx.TestFeatures(features); err != nil {
+		return reject(err.Error())
+	}
+	switch {
+	case trx.ChainTag() != t.repo.ChainTag():
+		return reject("chain tag mismatch")
+	case blockCtx.Number < trx.BlockRef().Number():
+		return reject("block ref out of schedule")
+	case trx.IsExpired(blockCtx.Number):
+		return reject("expired")
+	case trx.Gas() > blockCtx.GasLimit:
+		return reject("gas exceeds block gas limit")
+	}
+
+	if _, err := rt.Chain().GetTransactionMeta(result.ID); err == nil {
+		return reject("known tx")
+	} else if !t.repo.IsNotFound(err) {
+		return nil, err
+	}
+	if dependsOn := trx.DependsOn(); dependsOn != nil {
+		meta, err := rt.Chain().GetTransactionMeta(*dependsOn)
+		if err != nil {
+			if t.repo.IsNotFound(err) {
+				return reject("dependent tx not found")
+			}
+			return nil, err
+		}
+		if meta.Reverted {
+			return reject("dependent tx reverted")
+		}
+	}
+
+	var resolvedTx *runtime.ResolvedTransaction
+	if len(trx.Signature()) == 0 {
+		resolvedTx, err = runtime.ResolveUnsignedTransaction(trx, origin, delegator)
+	} else {
+		resolvedTx, err = runtime.ResolveTransaction(trx)
+	}
+	if err != nil {
+		return reject(err.Error())
+	}
+	executor, err := rt.PrepareResolvedTransaction(resolvedTx)
+	if err != nil {
+		if _, ok := err.(*state.Error); ok {
+			return nil, err
+		}
+		return reject(err.Error())
+	}
+
+	for executor.HasNextClause() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		gasUsed, output, err := executor.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		result.Results = append(result.Results, convertClauseResult(output, gasUsed))
+	}
+	receipt, err := executor.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	result.Receipt = buildReceipt(receipt, ReceiptMeta{
+		TxID:           result.ID,
+		TxOrigin:       origin,
+		BlockNumber:    blockCtx.Number,
+		BlockTimestamp: blockCtx.Time,
+	}, trx.Clauses())
+	return result, nil
+}
+
+func (t *Transactions) handleSimulateTransaction(w http.ResponseWriter, req *http.Request) error {
+	var data SimulateTxData
+	if err := utils.ParseJSON(req.Body, &data); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	h, err := utils.GetHeaderByRevision(t.repo, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	result, err := t.simulate(req.Context(), &data, h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, result)
+}
+
+const (
+	// number of recent blocks to measure fullness
+	fullnessBlocks = 10
+	// fullness threshold, above which gas price coef is suggested to be raised
+	fullnessThreshold = 0.5
+)
+
+// executeClauses executes clauses with the given gas as a tx does, and returns the gas used.
+// Each execution is on a fresh state.
+func (t *Transactions) executeClauses(
+	ctx context.Context,
+	parent *block.Header,
+	clauses []*tx.Clause,
+	txCtx *xenv.TransactionContext,
+	gas uint64,
+	intrinsicGas uint64,
+) (gasUsed uint64, vmErr error, err error) {
+	if gas < intrinsicGas {
+		return 0, errors.New("intrinsic gas exceeds provided gas"), nil
+	}
+	rt, _, err := t.newRuntime(parent)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	leftOverGas := gas - intrinsicGas
+	for i, clause := range clauses {
+		exec, interrupt := rt.PrepareClause(clause, uint32(i), leftOverGas, txCtx)
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				interrupt()
+			case <-done:
+			}
+		}()
+		output, interrupted, err := exec()
+		close(done)
+		if err != nil {
+			return 0, nil, err
+		}
+		if interrupted {
+			return 0, nil, ctx.Err()
+		}
+		used := leftOverGas - output.LeftOverGas
+		leftOverGas = output.LeftOverGas
+
+		// same refund rule as tx execution
+		refund := used / 2
+		if refund > output.RefundGas {
+			refund = output.RefundGas
+		}
+		leftOverGas += refund
+
+		if output.VMErr != nil {
+			return gas - leftOverGas, output.VMErr, nil
+		}
+	}
+	return gas - leftOverGas, nil, nil
+}
+
+// estimateGas binary searches the minimal gas to execute clauses without vm error.
+// The max gas is the block gas limit, capped by the call gas limit.
+// If clauses fail even with the max gas, the gas used and the vm error are returned.
+func (t *Transactions) estimateGas(
+	ctx context.Context,
+	parent *block.Header,
+	clauses []*tx.Clause,
+	txCtx *xenv.TransactionContext,
+	intrinsicGas uint64,
+) (gas uint64, gasUsed uint64, vmErr error, err error) {
+	hi := parent.GasLimit()
+	if hi > t.callGasLimit {
+		hi = t.callGasLimit
+	}
+	gasUsed, vmErr, err = t.executeClauses(ctx, parent, clauses, txCtx, hi, intrinsicGas)
+	if err != nil || vmErr != nil {
+		return hi, gasUsed, vmErr, err
+	}
+
+	// gas less than used must fail
+	lo := gasUsed - 1
+	for lo+1 < hi {
+		mid := (lo + hi) / 2
+		used, vmErr, err := t.executeClauses(ctx, parent, clauses, txCtx, mid, intrinsicGas)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		if vmErr != nil {
+			lo = mid
+		} else {
+			hi = mid
+			gasUsed = used
+		}
+	}
+	return hi, gasUsed, nil, nil
+}
+
+// suggestGasPriceCoef suggests gas price coef for a tx with the given gas to be packed soon.
+// Two factors are considered, and the larger suggestion wins:
+//   - the recent block fullness. Coef raises linearly from 0 to 255, as fullness goes from the threshold to 100%.
+//   - executables in tx pool. The tx should outbid the executables which would fill up the next block.
+func (t *Transactions) suggestGasPriceCoef(gas uint64, baseGasPrice *big.Int) (uint8, error) {
+	best := t.repo.BestBlock().Header()
+	chain := t.repo.NewBestChain()
+
+	// recent block fullness
+	var sumUsed, sumLimit uint64
+	header := best
+	for i := 0; i < fullnessBlocks; i++ {
+		sumUsed += header.GasUsed()
+		sumLimit += header.GasLimit()
+		if header.Number() == 0 {
+			break
+		}
+		h, err := chain.GetBlockHeader(header.Number() - 1)
+		if err != nil {
+			return 0, err
+		}
+		header = h
+	}
+	var coef uint8
+	if fullness := float64(sumUsed) / float64(sumLimit); fullness > fullnessThreshold {
+		coef = uint8((fullness - fullnessThreshold) / (1 - fullnessThreshold) * math.MaxUint8)
+	}
+
+	// executables are sorted by overall gas price from high to low
+	if t.pool == nil {
+		return coef, nil
+	}
+	var pendingGas uint64
+	for _, pending := range t.pool.Executables() {
+		pendingGas += pending.Gas()
+		if pendingGas+gas <= best.GasLimit() {
+			continue
+		}
+		provedWork, err := pending.ProvedWork(best.Number(), chain.GetBlockID)
+		if err != nil {
+			return 0, err
+		}
+		// to outbid this tx
+		price := pending.OverallGasPrice(baseGasPrice, provedWork)
+		price.Add(price, big.NewInt(1))
+
+		// coef = ceil((price - base) * 255 / base)
+		x := new(big.Int).Sub(price, baseGasPrice)
+		x.Mul(x, big.NewInt(math.MaxUint8))
+		x.Add(x, new(big.Int).Sub(baseGasPrice, big.NewInt(1)))
+		x.Div(x, baseGasPrice)
+		if x.Cmp(big.NewInt(math.MaxUint8)) > 0 {
+			x.SetInt64(math.MaxUint8)
+		}
+		if poolCoef := uint8(x.Uint64()); poolCoef > coef {
+			coef = poolCoef
+		}
+		break
+	}
+	return coef, nil
+}
+
+func (t *Transactions) estimate(ctx context.Context, data *EstimateData, parent *block.Header) (*EstimateResult, error) {
+	clauses, err := data.Clauses.decode()
+	if err != nil {
+		return nil, utils.BadRequest(err)
+	}
+	intrinsicGas, err := tx.IntrinsicGas(clauses...)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "clauses"))
+	}
+
+	baseGasPrice, err := builtin.Params.Native(t.stater.NewState(parent.StateRoot())).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	provedWork := new(big.Int)
+	if data.ProvedWork != nil {
+		provedWork = (*big.Int)(data.ProvedWork)
+	}
+
+	// the tx to calculate prices
+	priced := new(tx.Builder).
+		GasPriceCoef(data.GasPriceCoef).
+		BlockRef(tx.NewBlockRef(parent.Number()))
+
+	txCtx := &xenv.TransactionContext{
+		GasPrice:   priced.Build().GasPrice(baseGasPrice),
+		ProvedWork: provedWork,
+		BlockRef:   tx.NewBlockRef(parent.Number()),
+	}
+	if data.Caller != nil {
+		txCtx.Origin = *data.Caller
+		txCtx.GasPayer = *data.Caller
+	}
+	if data.GasPayer != nil {
+		txCtx.GasPayer = *data.GasPayer
+	}
+
+	gas, gasUsed, vmErr, err := t.estimateGas(ctx, parent, clauses, txCtx, intrinsicGas)
+	if err != nil {
+		return nil, err
+	}
+	pricedTx := priced.Gas(gas).Build()
+	gasPrice := pricedTx.GasPrice(baseGasPrice)
+	overallGasPrice := pricedTx.OverallGasPrice(baseGasPrice, provedWork)
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)
+
+	suggested, err := t.suggestGasPriceCoef(gas, baseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EstimateResult{
+		Gas:                   gas,
+		GasUsed:               gasUsed,
+		IntrinsicGas:          intrinsicGas,
+		BaseGasPrice:          (*hexMath.HexOrDecimal256)(baseGasPrice),
+		GasPriceCoef:          data.GasPriceCoef,
+		GasPrice:              (*hexMath.HexOrDecimal256)(gasPrice),
+		OverallGasPrice:       (*hexMath.HexOrDecimal256)(overallGasPrice),
+		Fee:                   (*hexMath.HexOrDecimal256)(fee),
+		SuggestedGasPriceCoef: suggested,
+	}
+	if vmErr != nil {
+		result.Reverted = true
+		result.VMError = vmErr.Error()
+	}
+	return result, nil
+}
+
+func (t *Transactions) handleEstimate(w http.ResponseWriter, req *http.Request) error {
+	var data EstimateData
+	if err := utils.ParseJSON(req.Body, &data); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	h, err := utils.GetHeaderByRevision(t.repo, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	result, err := t.estimate(req.Context(), &data, h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, result)
+}
+
 func (t *Transactions) parseHead(head string) (thor.Bytes32, error) {
 	if head == "" {
 		return t.repo.BestBlock().Header().ID(), nil
@@ -216,6 +725,8 @@ func (t *Transactions) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleSendTransaction))
+	sub.Path("/simulate").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleSimulateTransaction))
+	sub.Path("/estimate").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleEstimate))
 	sub.Path("/{id}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionByID))
 	sub.Path("/{id}/receipt").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionReceiptByID))
 }
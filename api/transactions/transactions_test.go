@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
@@ -34,6 +35,14 @@ import (
 var repo *chain.Repository
 var ts *httptest.Server
 var transaction *tx.Transaction
+var revertedTx *tx.Transaction
+
+// init code reverts with Error("boom")
+var revertCode = "0x6064600c60003960646000fd" +
+	"08c379a0" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000000000004" +
+	"626f6f6d00000000000000000000000000000000000000000000000000000000"
 
 func TestTransaction(t *testing.T) {
 	initTransactionServer(t)
@@ -41,6 +50,8 @@ func TestTransaction(t *testing.T) {
 	getTx(t)
 	getTxReceipt(t)
 	senTx(t)
+	simulateTx(t)
+	estimateTx(t)
 }
 
 func getTx(t *testing.T) {
@@ -70,6 +81,25 @@ func getTxReceipt(t *testing.T) {
 		t.Fatal(err)
 	}
 	assert.Equal(t, uint64(receipt.GasUsed), transaction.Gas(), "gas should be equal")
+	assert.Equal(t, "", receipt.RevertReason)
+
+	r = httpGet(t, ts.URL+"/transactions/"+revertedTx.ID().String()+"/receipt")
+	receipt = nil
+	if err := json.Unmarshal(r, &receipt); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, receipt.Reverted)
+	assert.Equal(t, "", receipt.RevertReason)
+
+	r = httpGet(t, ts.URL+"/transactions/"+revertedTx.ID().String()+"/receipt?withRevertReason=true")
+	receipt = nil
+	if err := json.Unmarshal(r, &receipt); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, receipt.Reverted)
+	assert.Equal(t, "evm: execution reverted", receipt.VMError)
+	assert.Equal(t, "0x"+revertCode[len(revertCode)-200:], receipt.RevertData)
+	assert.Equal(t, "boom", receipt.RevertReason)
 }
 
 func senTx(t *testing.T) {
@@ -102,6 +132,194 @@ func senTx(t *testing.T) {
 	assert.Equal(t, tx.ID().String(), txObj["id"], "should be the same transaction id")
 }
 
+func simulateTx(t *testing.T) {
+	to := thor.BytesToAddress([]byte("to"))
+	signed := new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Expiration(10).
+		Gas(21000).
+		Nonce(2).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+		Build()
+	sig, err := crypto.Sign(signed.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed = signed.WithSignature(sig)
+	rlpTx, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result transactions.SimulateResult
+	res := httpPost(t, ts.URL+"/transactions/simulate", &transactions.SimulateTxData{Raw: hexutil.Encode(rlpTx)})
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Rejected)
+	assert.Equal(t, signed.ID(), result.ID)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, result.Origin)
+	assert.Equal(t, uint64(21000), result.Receipt.GasUsed)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, result.Receipt.GasPayer)
+	assert.Equal(t, 1, len(result.Results))
+	assert.Equal(t, 1, len(result.Receipt.Outputs[0].Transfers))
+
+	// unsigned tx with explicit origin
+	origin := genesis.DevAccounts()[1].Address
+	data := &transactions.SimulateTxData{
+		ChainTag:   repo.ChainTag(),
+		Expiration: 10,
+		Gas:        100000,
+		Clauses: transactions.Clauses{
+			{To: nil, Data: "0x6080604052348015600f57600080fd5b50603580601d6000396000f3006080604052600080fd00a165627a7a72305820"},
+		},
+		Origin: &origin,
+	}
+	res = httpPost(t, ts.URL+"/transactions/simulate", data)
+	result = transactions.SimulateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Rejected)
+	assert.Equal(t, origin, result.Origin)
+	assert.False(t, result.ID.IsZero())
+	assert.Equal(t, origin, result.Receipt.GasPayer)
+	assert.Equal(t, thor.CreateContractAddress(result.ID, 0, 0), *result.Receipt.Outputs[0].ContractAddress)
+
+	// delegation is not activated
+	data.Delegator = &origin
+	res = httpPost(t, ts.URL+"/transactions/simulate", data)
+	result = transactions.SimulateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Rejected)
+	assert.Equal(t, "unsupported features", result.RejectReason)
+
+	// origin without energy
+	poor := thor.BytesToAddress([]byte("poor"))
+	data.Delegator = nil
+	data.Origin = &poor
+	res = httpPost(t, ts.URL+"/transactions/simulate", data)
+	result = transactions.SimulateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Rejected)
+	assert.Equal(t, "insufficient energy", result.RejectReason)
+	assert.Nil(t, result.Receipt)
+
+	// expired
+	data.BlockRef = hexutil.Encode([]byte{0, 0, 0, 0, 0, 0, 0, 0})
+	data.Expiration = 0
+	res = httpPost(t, ts.URL+"/transactions/simulate", data)
+	result = transactions.SimulateResult{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Rejected)
+	assert.Equal(t, "expired", result.RejectReason)
+
+	// origin is required for unsigned tx
+	data.Origin = nil
+	r, err := http.Post(ts.URL+"/transactions/simulate", "application/json", bytes.NewReader(mustMarshal(t, data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, r.StatusCode)
+}
+
+func estimateTx(t *testing.T) {
+	to := thor.BytesToAddress([]byte("to"))
+	caller := genesis.DevAccounts()[0].Address
+	var result transactions.EstimateResult
+	res := httpPost(t, ts.URL+"/transactions/estimate", &transactions.EstimateData{
+		Clauses: transactions.Clauses{
+			{To: &to, Value: math.HexOrDecimal256(*big.NewInt(1))},
+		},
+		Caller:       &caller,
+		GasPriceCoef: 128,
+	})
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Reverted)
+	assert.Equal(t, uint64(21000), result.IntrinsicGas)
+	assert.Equal(t, uint64(21000), result.Gas)
+	assert.Equal(t, uint64(21000), result.GasUsed)
+	assert.Equal(t, thor.InitialBaseGasPrice, (*big.Int)(result.BaseGasPrice))
+	gasPrice := new(big.Int).Mul(thor.InitialBaseGasPrice, big.NewInt(128))
+	gasPrice.Div(gasPrice, big.NewInt(255))
+	gasPrice.Add(gasPrice, thor.InitialBaseGasPrice)
+	assert.Equal(t, gasPrice, (*big.Int)(result.GasPrice))
+	assert.Equal(t, gasPrice, (*big.Int)(result.OverallGasPrice))
+	assert.Equal(t, new(big.Int).Mul(gasPrice, big.NewInt(21000)), (*big.Int)(result.Fee))
+	assert.Equal(t, uint8(0), result.SuggestedGasPriceCoef)
+
+	// contract deployment needs more than intrinsic gas
+	result = transactions.EstimateResult{}
+	res = httpPost(t, ts.URL+"/transactions/estimate", &transactions.EstimateData{
+		Clauses: transactions.Clauses{
+			{Data: "0x6080604052348015600f57600080fd5b50603580601d6000396000f3006080604052600080fd00a165627a7a72305820"},
+		},
+	})
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Reverted, result.VMError)
+	assert.True(t, result.Gas > result.IntrinsicGas)
+	assert.True(t, result.Gas >= result.GasUsed)
+
+	// exact gas passes simulation, while one less fails
+	origin := genesis.DevAccounts()[0].Address
+	data := &transactions.SimulateTxData{
+		ChainTag:   repo.ChainTag(),
+		Expiration: 10,
+		Gas:        result.Gas,
+		Clauses: transactions.Clauses{
+			{Data: "0x6080604052348015600f57600080fd5b50603580601d6000396000f3006080604052600080fd00a165627a7a72305820"},
+		},
+		Origin: &origin,
+	}
+	var simulated transactions.SimulateResult
+	if err := json.Unmarshal(httpPost(t, ts.URL+"/transactions/simulate", data), &simulated); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, simulated.Receipt.Reverted)
+	assert.Equal(t, result.GasUsed, simulated.Receipt.GasUsed)
+
+	data.Gas = result.Gas - 1
+	simulated = transactions.SimulateResult{}
+	if err := json.Unmarshal(httpPost(t, ts.URL+"/transactions/simulate", data), &simulated); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, simulated.Receipt.Reverted)
+
+	// always reverted
+	result = transactions.EstimateResult{}
+	res = httpPost(t, ts.URL+"/transactions/estimate", &transactions.EstimateData{
+		Clauses: transactions.Clauses{
+			{Data: "0x600080fd"},
+		},
+	})
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Reverted)
+	assert.Equal(t, "evm: execution reverted", result.VMError)
+	// capped by the call gas limit
+	assert.Equal(t, uint64(5000000), result.Gas)
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
 func httpPost(t *testing.T, url string, obj interface{}) []byte {
 	data, err := json.Marshal(obj)
 	if err != nil {
@@ -146,12 +364,30 @@ func initTransactionServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	transaction = transaction.WithSignature(sig)
+
+	revertedTx = new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Expiration(10).
+		Gas(100000).
+		Nonce(2).
+		Clause(tx.NewClause(nil).WithData(hexutil.MustDecode(revertCode))).
+		BlockRef(tx.NewBlockRef(0)).
+		Build()
+	sig, err = crypto.Sign(revertedTx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revertedTx = revertedTx.WithSignature(sig)
+
 	packer := packer.New(repo, stater, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address, thor.NoFork)
 	flow, err := packer.Schedule(b.Header(), uint64(time.Now().Unix()))
 	err = flow.Adopt(transaction)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if err := flow.Adopt(revertedTx); err != nil {
+		t.Fatal(err)
+	}
 	b, stage, receipts, err := flow.Pack(genesis.DevAccounts()[0].PrivateKey)
 	if err != nil {
 		t.Fatal(err)
@@ -166,7 +402,7 @@ func initTransactionServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	transactions.New(repo, txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})).Mount(router, "/transactions")
+	transactions.New(repo, stater, txpool.New(repo, stater, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute}), 5000000, thor.NoFork).Mount(router, "/transactions")
 	ts = httptest.NewServer(router)
 
 }
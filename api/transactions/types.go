@@ -7,11 +7,14 @@ package transactions
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
@@ -35,6 +38,23 @@ func convertClause(c *tx.Clause) Clause {
 	}
 }
 
+// decode converts json format clauses into raw clauses.
+func (cs Clauses) decode() ([]*tx.Clause, error) {
+	clauses := make([]*tx.Clause, 0, len(cs))
+	for i, c := range cs {
+		var data []byte
+		if c.Data != "" {
+			var err error
+			if data, err = hexutil.Decode(c.Data); err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("clauses[%d].data", i))
+			}
+		}
+		value := big.Int(c.Value)
+		clauses = append(clauses, tx.NewClause(c.To).WithValue(&value).WithData(data))
+	}
+	return clauses, nil
+}
+
 func (c *Clause) String() string {
 	return fmt.Sprintf(`Clause(
 		To    %v
@@ -83,8 +103,8 @@ type rawTransaction struct {
 	Meta *TxMeta `json:"meta"`
 }
 
-//convertTransaction convert a raw transaction into a json format transaction
-func convertTransaction(tx *tx.Transaction, header *block.Header) *Transaction {
+//ConvertTransaction convert a raw transaction into a json format transaction
+func ConvertTransaction(tx *tx.Transaction, header *block.Header) *Transaction {
 	//tx origin
 	origin, _ := tx.Origin()
 	delegator, _ := tx.Delegator()
@@ -142,6 +162,11 @@ type Receipt struct {
 	Reverted bool                  `json:"reverted"`
 	Meta     ReceiptMeta           `json:"meta"`
 	Outputs  []*Output             `json:"outputs"`
+
+	// recovered by re-executing the reverted tx, only if requested
+	VMError      string `json:"vmError,omitempty"`
+	RevertData   string `json:"revertData,omitempty"`
+	RevertReason string `json:"revertReason,omitempty"`
 }
 
 // Output output of clause execution.
@@ -167,59 +192,197 @@ type Transfer struct {
 
 //ConvertReceipt convert a raw clause into a jason format clause
 func convertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transaction) (*Receipt, error) {
-	reward := math.HexOrDecimal256(*txReceipt.Reward)
-	paid := math.HexOrDecimal256(*txReceipt.Paid)
 	origin, err := tx.Origin()
 	if err != nil {
 		return nil, err
 	}
+	return buildReceipt(txReceipt, ReceiptMeta{
+		header.ID(),
+		header.Number(),
+		header.Timestamp(),
+		tx.ID(),
+		origin,
+	}, tx.Clauses()), nil
+}
+
+func buildReceipt(txReceipt *tx.Receipt, meta ReceiptMeta, clauses []*tx.Clause) *Receipt {
+	reward := math.HexOrDecimal256(*txReceipt.Reward)
+	paid := math.HexOrDecimal256(*txReceipt.Paid)
 	receipt := &Receipt{
 		GasUsed:  txReceipt.GasUsed,
 		GasPayer: txReceipt.GasPayer,
 		Paid:     &paid,
 		Reward:   &reward,
 		Reverted: txReceipt.Reverted,
-		Meta: ReceiptMeta{
-			header.ID(),
-			header.Number(),
-			header.Timestamp(),
-			tx.ID(),
-			origin,
-		},
+		Meta:     meta,
 	}
 	receipt.Outputs = make([]*Output, len(txReceipt.Outputs))
 	for i, output := range txReceipt.Outputs {
-		clause := tx.Clauses()[i]
+		clause := clauses[i]
 		var contractAddr *thor.Address
 		if clause.To() == nil {
-			cAddr := thor.CreateContractAddress(tx.ID(), uint32(i), 0)
+			cAddr := thor.CreateContractAddress(meta.TxID, uint32(i), 0)
 			contractAddr = &cAddr
 		}
 		otp := &Output{contractAddr,
-			make([]*Event, len(output.Events)),
-			make([]*Transfer, len(output.Transfers)),
+			convertEvents(output.Events),
+			convertTransfers(output.Transfers),
 		}
-		for j, txEvent := range output.Events {
-			event := &Event{
-				Address: txEvent.Address,
-				Data:    hexutil.Encode(txEvent.Data),
-			}
-			event.Topics = make([]thor.Bytes32, len(txEvent.Topics))
-			for k, topic := range txEvent.Topics {
-				event.Topics[k] = topic
-			}
-			otp.Events[j] = event
+		receipt.Outputs[i] = otp
+	}
+	return receipt
+}
 
+func convertEvents(txEvents tx.Events) []*Event {
+	events := make([]*Event, len(txEvents))
+	for j, txEvent := range txEvents {
+		event := &Event{
+			Address: txEvent.Address,
+			Data:    hexutil.Encode(txEvent.Data),
 		}
-		for j, txTransfer := range output.Transfers {
-			transfer := &Transfer{
-				Sender:    txTransfer.Sender,
-				Recipient: txTransfer.Recipient,
-				Amount:    (*math.HexOrDecimal256)(txTransfer.Amount),
-			}
-			otp.Transfers[j] = transfer
+		event.Topics = make([]thor.Bytes32, len(txEvent.Topics))
+		for k, topic := range txEvent.Topics {
+			event.Topics[k] = topic
 		}
-		receipt.Outputs[i] = otp
+		events[j] = event
+	}
+	return events
+}
+
+func convertTransfers(txTransfers tx.Transfers) []*Transfer {
+	transfers := make([]*Transfer, len(txTransfers))
+	for j, txTransfer := range txTransfers {
+		transfers[j] = &Transfer{
+			Sender:    txTransfer.Sender,
+			Recipient: txTransfer.Recipient,
+			Amount:    (*math.HexOrDecimal256)(txTransfer.Amount),
+		}
+	}
+	return transfers
+}
+
+//SimulateTxData represents the tx to be simulated.
+//Either raw tx or tx body fields should be given. Origin and delegator are required for unsigned tx.
+type SimulateTxData struct {
+	Raw          string              `json:"raw"`
+	ChainTag     byte                `json:"chainTag"`
+	BlockRef     string              `json:"blockRef"`
+	Expiration   uint32              `json:"expiration"`
+	Clauses      Clauses             `json:"clauses"`
+	GasPriceCoef uint8               `json:"gasPriceCoef"`
+	Gas          uint64              `json:"gas"`
+	DependsOn    *thor.Bytes32       `json:"dependsOn"`
+	Nonce        math.HexOrDecimal64 `json:"nonce"`
+	Origin       *thor.Address       `json:"origin"`
+	Delegator    *thor.Address       `json:"delegator"`
+}
+
+func (d *SimulateTxData) hasBody() bool {
+	return d.ChainTag != 0 ||
+		d.BlockRef != "" ||
+		d.Expiration != 0 ||
+		len(d.Clauses) > 0 ||
+		d.GasPriceCoef != 0 ||
+		d.Gas != 0 ||
+		d.DependsOn != nil ||
+		d.Nonce != 0
+}
+
+func (d *SimulateTxData) build() (*tx.Transaction, error) {
+	builder := new(tx.Builder).
+		ChainTag(d.ChainTag).
+		Expiration(d.Expiration).
+		GasPriceCoef(d.GasPriceCoef).
+		Gas(d.Gas).
+		DependsOn(d.DependsOn).
+		Nonce(uint64(d.Nonce))
+
+	if d.BlockRef != "" {
+		blockRef, err := hexutil.Decode(d.BlockRef)
+		if err != nil {
+			return nil, errors.WithMessage(err, "blockRef")
+		}
+		if len(blockRef) != 8 {
+			return nil, errors.New("blockRef: invalid length")
+		}
+		var br tx.BlockRef
+		copy(br[:], blockRef)
+		builder.BlockRef(br)
+	}
+	clauses, err := d.Clauses.decode()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clauses {
+		builder.Clause(c)
+	}
+	if d.Delegator != nil {
+		var features tx.Features
+		features.SetDelegated(true)
+		builder.Features(features)
 	}
-	return receipt, nil
+	return builder.Build(), nil
+}
+
+// ClauseResult is the execution result of a clause in simulation.
+type ClauseResult struct {
+	Data            string        `json:"data"`
+	Events          []*Event      `json:"events"`
+	Transfers       []*Transfer   `json:"transfers"`
+	GasUsed         uint64        `json:"gasUsed"`
+	Reverted        bool          `json:"reverted"`
+	VMError         string        `json:"vmError"`
+	ContractAddress *thor.Address `json:"contractAddress"`
+}
+
+func convertClauseResult(output *runtime.Output, gasUsed uint64) *ClauseResult {
+	result := &ClauseResult{
+		Data:            hexutil.Encode(output.Data),
+		Events:          convertEvents(output.Events),
+		Transfers:       convertTransfers(output.Transfers),
+		GasUsed:         gasUsed,
+		ContractAddress: output.ContractAddress,
+	}
+	if output.VMErr != nil {
+		result.Reverted = true
+		result.VMError = output.VMErr.Error()
+	}
+	return result
+}
+
+// SimulateResult is the result of tx simulation.
+// If the tx is rejected, the reason is given and receipt is nil.
+type SimulateResult struct {
+	ID           thor.Bytes32    `json:"id"`
+	Origin       thor.Address    `json:"origin"`
+	Delegator    *thor.Address   `json:"delegator"`
+	IntrinsicGas uint64          `json:"intrinsicGas"`
+	Rejected     bool            `json:"rejected"`
+	RejectReason string          `json:"rejectReason"`
+	Receipt      *Receipt        `json:"receipt"`
+	Results      []*ClauseResult `json:"results"`
+}
+
+//EstimateData represents clauses to estimate gas and fee for.
+type EstimateData struct {
+	Clauses      Clauses               `json:"clauses"`
+	Caller       *thor.Address         `json:"caller"`
+	GasPayer     *thor.Address         `json:"gasPayer"`
+	GasPriceCoef uint8                 `json:"gasPriceCoef"`
+	ProvedWork   *math.HexOrDecimal256 `json:"provedWork"`
+}
+
+// EstimateResult is the result of gas and fee estimation.
+type EstimateResult struct {
+	Gas                   uint64                `json:"gas"`
+	GasUsed               uint64                `json:"gasUsed"`
+	IntrinsicGas          uint64                `json:"intrinsicGas"`
+	Reverted              bool                  `json:"reverted"`
+	VMError               string                `json:"vmError"`
+	BaseGasPrice          *math.HexOrDecimal256 `json:"baseGasPrice"`
+	GasPriceCoef          uint8                 `json:"gasPriceCoef"`
+	GasPrice              *math.HexOrDecimal256 `json:"gasPrice"`
+	OverallGasPrice       *math.HexOrDecimal256 `json:"overallGasPrice"`
+	Fee                   *math.HexOrDecimal256 `json:"fee"`
+	SuggestedGasPriceCoef uint8                 `json:"suggestedGasPriceCoef"`
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/events"
@@ -17,12 +18,15 @@ import (
 	"github.com/vechain/thor/logdb"
 )
 
+// response header carries the cursor of the last returned transfer
+const nextCursorHeader = "x-thorest-next"
+
 type Transfers struct {
 	repo *chain.Repository
-	db   *logdb.LogDB
+	db   logdb.LogDB
 }
 
-func New(repo *chain.Repository, db *logdb.LogDB) *Transfers {
+func New(repo *chain.Repository, db logdb.LogDB) *Transfers {
 	return &Transfers{
 		repo,
 		db,
@@ -30,26 +34,32 @@ func New(repo *chain.Repository, db *logdb.LogDB) *Transfers {
 }
 
 //Filter query logs with option
-func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*FilteredTransfer, error) {
-	rng, err := events.ConvertRange(t.repo.NewBestChain(), filter.Range)
-	if err != nil {
-		return nil, err
+func (t *Transfers) filter(ctx context.Context, filter *TransferFilter) ([]*FilteredTransfer, *uint64, error) {
+	rng, trng := events.ConvertRange(filter.Range, filter.TimeRange)
+	if err := events.CheckRetained(t.repo, t.db, rng, trng); err != nil {
+		return nil, nil, err
 	}
 
 	transfers, err := t.db.FilterTransfers(ctx, &logdb.TransferFilter{
 		CriteriaSet: filter.CriteriaSet,
 		Range:       rng,
+		TimeRange:   trng,
+		Cursor:      (*uint64)(filter.Cursor),
 		Options:     filter.Options,
 		Order:       filter.Order,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	tLogs := make([]*FilteredTransfer, len(transfers))
 	for i, trans := range transfers {
 		tLogs[i] = convertTransfer(trans)
 	}
-	return tLogs, nil
+	if len(transfers) == 0 {
+		return tLogs, nil, nil
+	}
+	next := transfers[len(transfers)-1].Cursor()
+	return tLogs, &next, nil
 }
 
 func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Request) error {
@@ -57,15 +67,49 @@ func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Re
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	tLogs, err := t.filter(req.Context(), &filter)
+	tLogs, next, err := t.filter(req.Context(), &filter)
 	if err != nil {
 		return err
 	}
+	if next != nil {
+		w.Header().Set(nextCursorHeader, hexutil.EncodeUint64(*next))
+	}
 	return utils.WriteJSON(w, tLogs)
 }
 
+func (t *Transfers) handleStats(w http.ResponseWriter, req *http.Request) error {
+	var filter TransferStatsFilter
+	if err := utils.ParseJSON(req.Body, &filter); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if err := events.ValidateGrouping(filter.GroupBy, filter.BucketSize, logdb.GroupBySender, logdb.GroupByRecipient); err != nil {
+		return err
+	}
+	options, err := events.StatsOptions(filter.Options)
+	if err != nil {
+		return err
+	}
+	rng, trng := events.ConvertRange(filter.Range, filter.TimeRange)
+	if err := events.CheckRetained(t.repo, t.db, rng, trng); err != nil {
+		return err
+	}
+	stats, err := t.db.TransferStats(req.Context(), &logdb.TransferFilter{
+		CriteriaSet: filter.CriteriaSet,
+		Range:       rng,
+		TimeRange:   trng,
+		Cursor:      (*uint64)(filter.Cursor),
+		Options:     options,
+		Order:       filter.Order,
+	}, filter.GroupBy, filter.BucketSize)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, events.ConvertStats(stats))
+}
+
 func (t *Transfers) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleFilterTransferLogs))
+	sub.Path("/stats").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleStats))
 }
@@ -48,6 +48,15 @@ func convertTransfer(transfer *logdb.Transfer) *FilteredTransfer {
 type TransferFilter struct {
 	CriteriaSet []*logdb.TransferCriteria
 	Range       *events.Range
+	TimeRange   *events.TimeRange
+	Cursor      *math.HexOrDecimal64
 	Options     *logdb.Options
 	Order       logdb.Order //default asc
 }
+
+// TransferStatsFilter is the filter to aggregate transfers by groups.
+type TransferStatsFilter struct {
+	TransferFilter
+	GroupBy    logdb.GroupBy `json:"groupBy"`
+	BucketSize uint32        `json:"bucketSize"` // blocks per bucket, if grouped by block
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/thor"
+)
+
+// GetHeaderByRevision returns the block header specified by the revision, which is the block ID or number.
+// The best block returned if the revision is empty or 'best'.
+func GetHeaderByRevision(repo *chain.Repository, revision string) (*block.Header, error) {
+	if revision == "" || revision == "best" {
+		return repo.BestBlock().Header(), nil
+	}
+	if len(revision) == 66 || len(revision) == 64 {
+		blockID, err := thor.ParseBytes32(revision)
+		if err != nil {
+			return nil, BadRequest(errors.WithMessage(err, "revision"))
+		}
+		summary, err := repo.GetBlockSummary(blockID)
+		if err != nil {
+			if repo.IsNotFound(err) {
+				return nil, BadRequest(errors.WithMessage(err, "revision"))
+			}
+			return nil, err
+		}
+		return summary.Header, nil
+	}
+	n, err := strconv.ParseUint(revision, 0, 0)
+	if err != nil {
+		return nil, BadRequest(errors.WithMessage(err, "revision"))
+	}
+	if n > math.MaxUint32 {
+		return nil, BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
+	}
+	h, err := repo.NewBestChain().GetBlockHeader(uint32(n))
+	if err != nil {
+		if repo.IsNotFound(err) {
+			return nil, BadRequest(errors.WithMessage(err, "revision"))
+		}
+		return nil, err
+	}
+	return h, nil
+}
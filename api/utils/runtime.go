@@ -0,0 +1,28 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+var devNetGenesisID = thor.MustParseBytes32("0x00000000973ceb7f343a58b08f0693d6701a5fd354ff73d7058af3fba222aea4")
+
+// NewReplayRuntime creates the runtime to replay txs of the block of the given header.
+// PoA is skipped on devnet, whose blocks are packed by arbitrary signers.
+func NewReplayRuntime(repo *chain.Repository, stater *state.Stater, forkConfig thor.ForkConfig, header *block.Header) (*runtime.Runtime, error) {
+	skipPoA := repo.GenesisBlock().Header().ID() == devNetGenesisID
+	return consensus.New(
+		repo,
+		stater,
+		forkConfig,
+	).NewRuntimeForReplay(header, skipPoA)
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/tx"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+const (
+	logDBFileName        = "logs.db"
+	rebuildLogDBFileName = "logs.db.rebuild" // the log db being rebuilt, kept to resume if interrupted
+)
+
+// sqlite may keep data in these side files along with the main db file
+var sqliteSideFileSuffixes = []string{"-wal", "-shm"}
+
+// stoppedChain is the chain of a stopped node, to maintain its log db offline.
+type stoppedChain struct {
+	mainDB      *muxdb.MuxDB
+	repo        *chain.Repository
+	instanceDir string
+	genesisLogs tx.Receipts
+}
+
+func openStoppedChain(ctx *cli.Context) (*stoppedChain, error) {
+	gene, _, err := selectGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceDir, err := makeInstanceDir(ctx, gene)
+	if err != nil {
+		return nil, err
+	}
+	mainDB, err := openMainDB(ctx, instanceDir)
+	if err != nil {
+		return nil, errors.WithMessage(err, "is the node stopped?")
+	}
+
+	sc, err := newStoppedChain(gene, mainDB, instanceDir)
+	if err != nil {
+		mainDB.Close()
+		return nil, err
+	}
+	return sc, nil
+}
+
+func newStoppedChain(gene *genesis.Genesis, mainDB *muxdb.MuxDB, instanceDir string) (*stoppedChain, error) {
+	genesisBlock, genesisEvents, genesisTransfers, err := gene.Build(state.NewStater(mainDB))
+	if err != nil {
+		return nil, errors.Wrap(err, "build genesis block")
+	}
+	repo, err := chain.NewRepository(mainDB, genesisBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "initialize block chain")
+	}
+	return &stoppedChain{
+		mainDB:      mainDB,
+		repo:        repo,
+		instanceDir: instanceDir,
+		genesisLogs: tx.Receipts{{
+			Outputs: []*tx.Output{
+				{Events: genesisEvents, Transfers: genesisTransfers},
+			},
+		}},
+	}, nil
+}
+
+// logsDBURL returns the url of the PostgreSQL log db, which is given before or after the sub-command.
+func logsDBURL(ctx *cli.Context) string {
+	if url := ctx.String(logsDBURLFlag.Name); url != "" {
+		return url
+	}
+	return ctx.GlobalString(logsDBURLFlag.Name)
+}
+
+func logDBRebuildAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	initLogger(ctx)
+
+	if logsDBURL(ctx) != "" {
+		// the rebuilt log db is swapped in as files
+		return fmt.Errorf("flag %s not supported, drop tables of the PostgreSQL log db and it will be rebuilt by the node at startup", logsDBURLFlag.Name)
+	}
+
+	from := ctx.Int(fromBlockFlag.Name)
+	if from < 0 {
+		return fmt.Errorf("negative flag %s", fromBlockFlag.Name)
+	}
+	recordReverted := ctx.Bool(logsRevertedFlag.Name)
+
+	sc, err := openStoppedChain(ctx)
+	if err != nil {
+		return err
+	}
+	defer sc.mainDB.Close()
+
+	path, err := rebuildLogDB(exitSignal, sc, uint32(from), recordReverted)
+	if err != nil {
+		if err == context.Canceled {
+			fmt.Println("Interrupted, run again to resume")
+		}
+		return err
+	}
+	fmt.Println("Log db rebuilt:", path)
+	return nil
+}
+
+// rebuildLogDB rebuilds the log db in the instance dir from the given block, and returns its path.
+// The rebuilding is resumed if it was interrupted.
+func rebuildLogDB(ctx context.Context, sc *stoppedChain, from uint32, recordReverted bool) (string, error) {
+	var (
+		path        = filepath.Join(sc.instanceDir, logDBFileName)
+		rebuildPath = filepath.Join(sc.instanceDir, rebuildLogDBFileName)
+	)
+
+	if _, err := os.Stat(rebuildPath); err == nil {
+		fmt.Printf("Resuming the interrupted rebuilding, remove %v to start over\n", rebuildPath)
+	} else if os.IsNotExist(err) {
+		if err := prepareRebuildLogDB(sc.repo, from, path, rebuildPath, recordReverted); err != nil {
+			return "", err
+		}
+	} else {
+		return "", err
+	}
+
+	logDB, err := logdb.New(rebuildPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "open log database [%v]", rebuildPath)
+	}
+	logDB.RecordReverted(recordReverted)
+	// genesis logs, in case of rebuilding from scratch
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		return w.Write(sc.repo.GenesisBlock(), sc.genesisLogs)
+	}); err != nil {
+		logDB.Close()
+		return "", errors.Wrap(err, "write genesis logs")
+	}
+	if err := syncLogDB(ctx, sc.repo, logDB, false); err != nil {
+		logDB.Close()
+		return "", err
+	}
+	if err := logDB.Close(); err != nil {
+		return "", err
+	}
+
+	if err := swapLogDB(rebuildPath, path); err != nil {
+		return "", errors.Wrap(err, "swap log db")
+	}
+	return path, nil
+}
+
+// prepareRebuildLogDB makes the new log db, which keeps logs before the given block of the current log db.
+// The new log db appears only when it is ready, so that rebuilding can be resumed.
+func prepareRebuildLogDB(repo *chain.Repository, from uint32, path, rebuildPath string, recordReverted bool) error {
+	tmpPath := rebuildPath + ".tmp"
+	if err := removeLogDBFiles(tmpPath); err != nil {
+		return err
+	}
+
+	if from == 0 {
+		// the new log db is made empty when opened
+		return nil
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return errors.Wrapf(err, "rebuild from block %v", from)
+	}
+	if err := checkRebuildFrom(repo, from, path); err != nil {
+		return err
+	}
+
+	fmt.Println(">> Copying log db <<")
+	for _, suffix := range append([]string{""}, sqliteSideFileSuffixes...) {
+		if err := copyFile(path+suffix, tmpPath+suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	// truncate logs from the block by rewriting it
+	b, err := repo.NewBestChain().GetBlock(from)
+	if err != nil {
+		return err
+	}
+	receipts, err := repo.GetBlockReceipts(b.Header().ID())
+	if err != nil {
+		return errors.Wrap(err, "get block receipts")
+	}
+
+	logDB, err := logdb.New(tmpPath)
+	if err != nil {
+		return errors.Wrapf(err, "open log database [%v]", tmpPath)
+	}
+	logDB.RecordReverted(recordReverted)
+	if err := logDB.Log(func(w *logdb.Writer) error {
+		return w.Write(b, receipts)
+	}); err != nil {
+		logDB.Close()
+		return errors.Wrap(err, "truncate logs")
+	}
+	if err := logDB.Close(); err != nil {
+		return err
+	}
+	return renameLogDB(tmpPath, rebuildPath)
+}
+
+// checkRebuildFrom checks the current log db has logs right before the given block.
+func checkRebuildFrom(repo *chain.Repository, from uint32, path string) error {
+	logDB, err := logdb.New(path)
+	if err != nil {
+		return errors.Wrapf(err, "open log database [%v]", path)
+	}
+	defer logDB.Close()
+
+	newestID, err := logDB.NewestBlockID()
+	if err != nil {
+		return err
+	}
+	if newest := block.Number(newestID); from > newest+1 {
+		return fmt.Errorf("flag %s beyond the newest block %v in log db", fromBlockFlag.Name, newest)
+	}
+	if best := repo.BestBlock().Header().Number(); from > best {
+		return fmt.Errorf("flag %s beyond the best block %v", fromBlockFlag.Name, best)
+	}
+	return nil
+}
+
+func logDBVerifyAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+	initLogger(ctx)
+
+	from, to := ctx.Int(fromBlockFlag.Name), ctx.Int(toBlockFlag.Name)
+	if from < 0 || to < 0 {
+		return fmt.Errorf("negative flag %s or %s", fromBlockFlag.Name, toBlockFlag.Name)
+	}
+
+	sc, err := openStoppedChain(ctx)
+	if err != nil {
+		return err
+	}
+	defer sc.mainDB.Close()
+
+	logDB, err := openVerifiedLogDB(ctx, sc.instanceDir)
+	if err != nil {
+		return err
+	}
+	defer logDB.Close()
+
+	if !ctx.IsSet(toBlockFlag.Name) {
+		newestID, err := logDB.NewestBlockID()
+		if err != nil {
+			return err
+		}
+		to = int(block.Number(newestID))
+	}
+	if best := sc.repo.BestBlock().Header().Number(); uint32(to) > best {
+		return fmt.Errorf("flag %s beyond the best block %v", toBlockFlag.Name, best)
+	}
+	if from > to {
+		return fmt.Errorf("flag %s greater than %s", fromBlockFlag.Name, toBlockFlag.Name)
+	}
+
+	if err := verifyLogDB(exitSignal, uint32(from), uint32(to), sc.repo, logDB); err != nil {
+		return errors.Wrap(err, "verify log db")
+	}
+	fmt.Println("Log db verified")
+	return nil
+}
+
+// openVerifiedLogDB opens the log db to be verified, either the PostgreSQL one or the one in instance dir.
+func openVerifiedLogDB(ctx *cli.Context, instanceDir string) (logdb.LogDB, error) {
+	if url := logsDBURL(ctx); url != "" {
+		// read only, not to take the writer lease from the node sharing it
+		db, err := logdb.NewPostgresReader(url)
+		if err != nil {
+			// url not printed, it may contain the password
+			return nil, errors.Wrap(err, "open log database [postgres]")
+		}
+		return db, nil
+	}
+
+	path := filepath.Join(instanceDir, logDBFileName)
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	db, err := logdb.New(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open log database [%v]", path)
+	}
+	return db, nil
+}
+
+// swapLogDB atomically replaces the log db at path with the one at newPath.
+func swapLogDB(newPath, path string) error {
+	// stale side files must not be applied to the new db
+	for _, suffix := range sqliteSideFileSuffixes {
+		if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return renameLogDB(newPath, path)
+}
+
+// renameLogDB renames the db file along with its side files, the main file renamed last.
+func renameLogDB(from, to string) error {
+	for _, suffix := range sqliteSideFileSuffixes {
+		if err := os.Rename(from+suffix, to+suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(from, to)
+}
+
+func removeLogDBFiles(path string) error {
+	for _, suffix := range append([]string{""}, sqliteSideFileSuffixes...) {
+		if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var (
+	newLogsAddr = thor.BytesToAddress([]byte("new"))
+	oldLogsAddr = thor.BytesToAddress([]byte("old"))
+)
+
+// newTestChain makes a stopped chain of n blocks, each with a tx emitting the given count of events.
+func newTestChain(t *testing.T, n int, eventCount func(num uint32) int) *stoppedChain {
+	dir, err := ioutil.TempDir("", "thor-logdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc, err := newStoppedChain(genesis.NewDevnet(), muxdb.NewMem(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := sc.repo.GenesisBlock().Header()
+	for i := 1; i <= n; i++ {
+		trx := new(tx.Builder).ChainTag(sc.repo.ChainTag()).Nonce(uint64(i)).Gas(21000).Build()
+		sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := new(block.Builder).
+			ParentID(parent.ID()).
+			Timestamp(parent.Timestamp() + thor.BlockInterval).
+			Transaction(trx.WithSignature(sig)).
+			Build()
+		if err := sc.repo.AddBlock(b, testReceipts(newLogsAddr, eventCount(b.Header().Number()))); err != nil {
+			t.Fatal(err)
+		}
+		parent = b.Header()
+	}
+	if err := sc.repo.SetBestBlockID(parent.ID()); err != nil {
+		t.Fatal(err)
+	}
+	return sc
+}
+
+func testReceipts(addr thor.Address, eventCount int) tx.Receipts {
+	events := make(tx.Events, 0, eventCount)
+	for i := 0; i < eventCount; i++ {
+		events = append(events, &tx.Event{Address: addr, Topics: []thor.Bytes32{thor.BytesToBytes32([]byte{byte(i)})}})
+	}
+	return tx.Receipts{{Outputs: []*tx.Output{{Events: events}}}}
+}
+
+// writeTestLogDB writes logs of the chain into the log db at path, with events of the given address,
+// and leaves logs uncheckpointed in the -wal file, as a crashed node does.
+func writeTestLogDB(t *testing.T, sc *stoppedChain, path string, addr thor.Address) {
+	scratch := path + ".scratch"
+	db, err := logdb.New(scratch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		db.Close()
+		removeLogDBFiles(scratch)
+	}()
+
+	chain := sc.repo.NewBestChain()
+	if err := db.Log(func(w *logdb.Writer) error {
+		if err := w.Write(sc.repo.GenesisBlock(), sc.genesisLogs); err != nil {
+			return err
+		}
+		for i := uint32(1); i <= sc.repo.BestBlock().Header().Number(); i++ {
+			b, err := chain.GetBlock(i)
+			if err != nil {
+				return err
+			}
+			if err := w.Write(b, testReceipts(addr, 1)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// copied before closed, so the logs are in the -wal file
+	_, err = os.Stat(scratch + "-wal")
+	assert.Nil(t, err)
+	for _, suffix := range append([]string{""}, sqliteSideFileSuffixes...) {
+		if err := copyFile(scratch+suffix, path+suffix); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// blockEvents returns addresses of events in the log db at path, by block number, except the genesis block.
+func blockEvents(t *testing.T, path string) map[uint32][]thor.Address {
+	db, err := logdb.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	events, err := db.FilterEvents(context.Background(), &logdb.EventFilter{Range: &logdb.Range{From: 1, To: math.MaxUint32}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs := make(map[uint32][]thor.Address)
+	for _, ev := range events {
+		addrs[ev.BlockNumber] = append(addrs[ev.BlockNumber], ev.Address)
+	}
+	return addrs
+}
+
+func expectedEvents(n int, addr func(num uint32) thor.Address, eventCount func(num uint32) int) map[uint32][]thor.Address {
+	addrs := make(map[uint32][]thor.Address)
+	for num := uint32(1); num <= uint32(n); num++ {
+		for i := 0; i < eventCount(num); i++ {
+			addrs[num] = append(addrs[num], addr(num))
+		}
+	}
+	return addrs
+}
+
+func TestRebuildLogDBResumed(t *testing.T) {
+	// logs of block 1 are so many that they are flushed before interrupted
+	eventCount := func(num uint32) int {
+		if num == 1 {
+			return 1500
+		}
+		return 1
+	}
+	sc := newTestChain(t, 10, eventCount)
+	defer os.RemoveAll(sc.instanceDir)
+
+	var (
+		path        = filepath.Join(sc.instanceDir, logDBFileName)
+		rebuildPath = filepath.Join(sc.instanceDir, rebuildLogDBFileName)
+	)
+
+	interrupted, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := rebuildLogDB(interrupted, sc, 0, false)
+	assert.Equal(t, context.Canceled, err)
+	_, err = os.Stat(rebuildPath)
+	assert.Nil(t, err)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	// the progress is kept to resume from
+	db, err := logdb.New(rebuildPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newestID, err := db.NewestBlockID()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), block.Number(newestID))
+	db.Close()
+
+	rebuilt, err := rebuildLogDB(context.Background(), sc, 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, path, rebuilt)
+	_, err = os.Stat(rebuildPath)
+	assert.True(t, os.IsNotExist(err))
+
+	newAddr := func(uint32) thor.Address { return newLogsAddr }
+	assert.Equal(t, expectedEvents(10, newAddr, eventCount), blockEvents(t, path))
+}
+
+func TestRebuildLogDBFrom(t *testing.T) {
+	one := func(uint32) int { return 1 }
+	sc := newTestChain(t, 10, one)
+	defer os.RemoveAll(sc.instanceDir)
+
+	path := filepath.Join(sc.instanceDir, logDBFileName)
+	writeTestLogDB(t, sc, path, oldLogsAddr)
+
+	_, err := rebuildLogDB(context.Background(), sc, 12, false)
+	assert.EqualError(t, err, "flag from beyond the newest block 10 in log db")
+
+	_, err = rebuildLogDB(context.Background(), sc, 4, false)
+	assert.Nil(t, err)
+	for _, suffix := range sqliteSideFileSuffixes {
+		// stale ones removed, no logs lost from them
+		_, err := os.Stat(path + suffix)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	// logs before the block kept, and the rest rebuilt
+	addr := func(num uint32) thor.Address {
+		if num < 4 {
+			return oldLogsAddr
+		}
+		return newLogsAddr
+	}
+	assert.Equal(t, expectedEvents(10, addr, one), blockEvents(t, path))
+}
+
+func TestSwapLogDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thor-logdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		path    = filepath.Join(dir, logDBFileName)
+		newPath = filepath.Join(dir, rebuildLogDBFileName)
+	)
+	for _, name := range []string{path, path + "-wal", path + "-shm", newPath, newPath + "-wal"} {
+		if err := ioutil.WriteFile(name, []byte(filepath.Base(name)), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Nil(t, swapLogDB(newPath, path))
+
+	for name, content := range map[string]string{
+		path:          rebuildLogDBFileName,
+		path + "-wal": rebuildLogDBFileName + "-wal",
+	} {
+		data, err := ioutil.ReadFile(name)
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(data))
+	}
+	for _, name := range []string{path + "-shm", newPath, newPath + "-wal"} {
+		_, err := os.Stat(name)
+		assert.True(t, os.IsNotExist(err), name)
+	}
+}
@@ -77,6 +77,15 @@ func main() {
 			natFlag,
 			bootNodeFlag,
 			skipLogsFlag,
+			logsRetentionBlocksFlag,
+			logsRetentionDaysFlag,
+			logsDBURLFlag,
+			logsDBReadOnlyFlag,
+			logsRevertedFlag,
+			txPoolAdmissionRulesFlag,
+			txPoolBlocklistURLsFlag,
+			txPoolAllowlistFlag,
+			txPoolBlocklistKeysFlag,
 			pprofFlag,
 			verifyLogsFlag,
 			disablePrunerFlag,
@@ -101,8 +110,12 @@ func main() {
 					pprofFlag,
 					verifyLogsFlag,
 					skipLogsFlag,
+					logsRetentionBlocksFlag,
+					logsRetentionDaysFlag,
+					logsRevertedFlag,
 					txPoolLimitFlag,
 					txPoolLimitPerAccountFlag,
+					txPoolAdmissionRulesFlag,
 					disablePrunerFlag,
 				},
 				Action: soloAction,
@@ -117,6 +130,40 @@ func main() {
 				},
 				Action: masterKeyAction,
 			},
+			{
+				Name:  "logdb",
+				Usage: "log db maintenance of a stopped node",
+				Subcommands: []cli.Command{
+					{
+						Name:  "rebuild",
+						Usage: "rebuild logs from the given block into a new log db, and swap it in (resumable)",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							cacheFlag,
+							verbosityFlag,
+							fromBlockFlag,
+							logsRevertedFlag,
+							logsDBURLFlag,
+						},
+						Action: logDBRebuildAction,
+					},
+					{
+						Name:  "verify",
+						Usage: "verify logs in the given block range against the chain",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							cacheFlag,
+							verbosityFlag,
+							fromBlockFlag,
+							toBlockFlag,
+							logsDBURLFlag,
+						},
+						Action: logDBVerifyAction,
+					},
+				},
+			},
 		},
 	}
 
@@ -148,6 +195,8 @@ func defaultAction(ctx *cli.Context) error {
 	defer func() { log.Info("closing main database..."); mainDB.Close() }()
 
 	skipLogs := ctx.Bool(skipLogsFlag.Name)
+	// logs are written by another node sharing the log db
+	readOnlyLogs := ctx.Bool(logsDBReadOnlyFlag.Name)
 
 	logDB, err := openLogDB(ctx, instanceDir)
 	if err != nil {
@@ -155,7 +204,7 @@ func defaultAction(ctx *cli.Context) error {
 	}
 	defer func() { log.Info("closing log database..."); logDB.Close() }()
 
-	repo, err := initChainRepository(gene, mainDB, logDB)
+	repo, err := initChainRepository(gene, mainDB, logDB, !readOnlyLogs)
 	if err != nil {
 		return err
 	}
@@ -167,13 +216,29 @@ func defaultAction(ctx *cli.Context) error {
 
 	printStartupMessage1(gene, repo, master, instanceDir, forkConfig)
 
-	if !skipLogs {
+	logsRetention, err := parseLogsRetention(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !skipLogs && !readOnlyLogs {
 		if err := syncLogDB(exitSignal, repo, logDB, ctx.Bool(verifyLogsFlag.Name)); err != nil {
 			return err
 		}
+		if logsRetention > 0 {
+			pruner := newLogDBPruner(repo, logDB, logsRetention)
+			defer func() { log.Info("stopping log db pruner..."); pruner.Stop() }()
+		}
 	}
 
 	txpoolOpt := defaultTxPoolOptions
+	txpoolOpt.JournalFilePath = filepath.Join(instanceDir, "txpool.journal")
+	if txpoolOpt.AdmissionPolicy, err = loadTxPoolAdmissionPolicy(ctx); err != nil {
+		return err
+	}
+	if err := loadTxPoolBlocklistOptions(ctx, &txpoolOpt, instanceDir); err != nil {
+		return err
+	}
 	txPool := txpool.New(repo, state.NewStater(mainDB), txpoolOpt)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
@@ -222,7 +287,7 @@ func defaultAction(ctx *cli.Context) error {
 		filepath.Join(instanceDir, "tx.stash"),
 		p2pcom.comm,
 		uint64(ctx.Int(targetGasLimitFlag.Name)),
-		skipLogs,
+		skipLogs || readOnlyLogs,
 		forkConfig).Run(exitSignal)
 }
 
@@ -236,7 +301,7 @@ func soloAction(ctx *cli.Context) error {
 	forkConfig := thor.ForkConfig{}
 
 	var mainDB *muxdb.MuxDB
-	var logDB *logdb.LogDB
+	var logDB logdb.LogDB
 	var instanceDir string
 	var err error
 
@@ -256,24 +321,40 @@ func soloAction(ctx *cli.Context) error {
 		instanceDir = "Memory"
 		mainDB = openMemMainDB()
 		logDB = openMemLogDB()
+		logDB.RecordReverted(ctx.Bool(logsRevertedFlag.Name))
 	}
 
-	repo, err := initChainRepository(gene, mainDB, logDB)
+	repo, err := initChainRepository(gene, mainDB, logDB, true)
 	if err != nil {
 		return err
 	}
 
 	skipLogs := ctx.Bool(skipLogsFlag.Name)
 
+	logsRetention, err := parseLogsRetention(ctx)
+	if err != nil {
+		return err
+	}
+
 	if !skipLogs {
 		if err := syncLogDB(exitSignal, repo, logDB, ctx.Bool(verifyLogsFlag.Name)); err != nil {
 			return err
 		}
+		if logsRetention > 0 {
+			pruner := newLogDBPruner(repo, logDB, logsRetention)
+			defer func() { log.Info("stopping log db pruner..."); pruner.Stop() }()
+		}
 	}
 
 	txPoolOption := defaultTxPoolOptions
 	txPoolOption.Limit = ctx.Int(txPoolLimitFlag.Name)
 	txPoolOption.LimitPerAccount = ctx.Int(txPoolLimitPerAccountFlag.Name)
+	if ctx.Bool(persistFlag.Name) {
+		txPoolOption.JournalFilePath = filepath.Join(instanceDir, "txpool.journal")
+	}
+	if txPoolOption.AdmissionPolicy, err = loadTxPoolAdmissionPolicy(ctx); err != nil {
+		return err
+	}
 
 	txPool := txpool.New(repo, state.NewStater(mainDB), txPoolOption)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
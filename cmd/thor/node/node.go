@@ -45,7 +45,7 @@ type Node struct {
 
 	master         *Master
 	repo           *chain.Repository
-	logDB          *logdb.LogDB
+	logDB          logdb.LogDB
 	txPool         *txpool.TxPool
 	txStashPath    string
 	comm           *comm.Communicator
@@ -60,7 +60,7 @@ func New(
 	master *Master,
 	repo *chain.Repository,
 	stater *state.Stater,
-	logDB *logdb.LogDB,
+	logDB logdb.LogDB,
 	txPool *txpool.TxPool,
 	txStashPath string,
 	comm *comm.Communicator,
@@ -231,8 +231,8 @@ func (n *Node) txStashLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case txEv := <-txCh:
-			// skip executables
-			if txEv.Executable != nil && *txEv.Executable {
+			// skip executables, and txs removed from the pool
+			if txEv.Status != txpool.TxStatusAdded || (txEv.Executable != nil && *txEv.Executable) {
 				continue
 			}
 			// only stash non-executable txs
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// interval to check whether logs are out of the retention window
+const logDBPruneInterval = 10 * time.Minute
+
+// parseLogsRetention returns the number of recent blocks whose logs should be kept, 0 means keeping all.
+func parseLogsRetention(ctx *cli.Context) (uint32, error) {
+	blocks := ctx.Int(logsRetentionBlocksFlag.Name)
+	days := ctx.Int(logsRetentionDaysFlag.Name)
+	if blocks < 0 || days < 0 {
+		return 0, fmt.Errorf("negative logs retention")
+	}
+	if blocks > 0 && days > 0 {
+		return 0, fmt.Errorf("flag %s and %s are exclusive", logsRetentionBlocksFlag.Name, logsRetentionDaysFlag.Name)
+	}
+	if days > 0 {
+		// blocks may be missed, so more than N days could be kept
+		return uint32(uint64(days) * 24 * 3600 / thor.BlockInterval), nil
+	}
+	return uint32(blocks), nil
+}
+
+// logDBPruner periodically deletes logs out of the retention window.
+type logDBPruner struct {
+	repo   *chain.Repository
+	logDB  logdb.LogDB
+	retain uint32
+	ctx    context.Context
+	cancel func()
+	goes   co.Goes
+}
+
+func newLogDBPruner(repo *chain.Repository, logDB logdb.LogDB, retain uint32) *logDBPruner {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &logDBPruner{
+		repo:   repo,
+		logDB:  logDB,
+		retain: retain,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	p.goes.Go(p.loop)
+	return p
+}
+
+// Stop stops the pruner.
+func (p *logDBPruner) Stop() {
+	p.cancel()
+	p.goes.Wait()
+}
+
+func (p *logDBPruner) loop() {
+	ticker := time.NewTicker(logDBPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		best := p.repo.BestBlock().Header().Number()
+		if best > p.retain {
+			before := best - p.retain + 1
+			if before > p.logDB.RetainedFrom() {
+				start := time.Now()
+				if err := p.logDB.Prune(p.ctx, before); err != nil {
+					if err != context.Canceled {
+						log.Warn("failed to prune log db", "err", err)
+					}
+				} else {
+					log.Debug("log db pruned", "before", before, "elapsed", time.Since(start))
+				}
+			}
+		}
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -34,7 +34,7 @@ type Solo struct {
 	repo        *chain.Repository
 	txPool      *txpool.TxPool
 	packer      *packer.Packer
-	logDB       *logdb.LogDB
+	logDB       logdb.LogDB
 	gasLimit    uint64
 	bandwidth   bandwidth.Bandwidth
 	onDemand    bool
@@ -45,7 +45,7 @@ type Solo struct {
 func New(
 	repo *chain.Repository,
 	stater *state.Stater,
-	logDB *logdb.LogDB,
+	logDB logdb.LogDB,
 	txPool *txpool.TxPool,
 	gasLimit uint64,
 	onDemand bool,
@@ -21,13 +21,13 @@ import (
 	"gopkg.in/cheggaaa/pb.v1"
 )
 
-func syncLogDB(ctx context.Context, repo *chain.Repository, logDB *logdb.LogDB, verify bool) error {
+func syncLogDB(ctx context.Context, repo *chain.Repository, logDB logdb.LogDB, verify bool) error {
 	startPos, err := seekLogDBSyncPosition(repo, logDB)
 	if err != nil {
 		return errors.Wrap(err, "seek log db sync position")
 	}
 	if verify && startPos > 0 {
-		if err := verifyLogDB(ctx, startPos-1, repo, logDB); err != nil {
+		if err := verifyLogDB(ctx, 0, startPos-1, repo, logDB); err != nil {
 			return errors.Wrap(err, "verify log db")
 		}
 	}
@@ -90,7 +90,7 @@ func syncLogDB(ctx context.Context, repo *chain.Repository, logDB *logdb.LogDB,
 	return nil
 }
 
-func seekLogDBSyncPosition(repo *chain.Repository, logDB *logdb.LogDB) (uint32, error) {
+func seekLogDBSyncPosition(repo *chain.Repository, logDB logdb.LogDB) (uint32, error) {
 	best := repo.BestBlock().Header()
 	if best.Number() == 0 {
 		return 0, nil
@@ -119,7 +119,9 @@ func seekLogDBSyncPosition(repo *chain.Repository, logDB *logdb.LogDB) (uint32,
 		return 0, err
 	}
 
-	for header.Number() > 0 {
+	// logs before the retained block were pruned
+	retainedFrom := logDB.RetainedFrom()
+	for header.Number() > 0 && header.Number() >= retainedFrom {
 		has, err := logDB.HasBlockID(header.ID())
 		if err != nil {
 			return 0, err
@@ -138,7 +140,8 @@ func seekLogDBSyncPosition(repo *chain.Repository, logDB *logdb.LogDB) (uint32,
 
 }
 
-func verifyLogDB(ctx context.Context, endBlockNum uint32, repo *chain.Repository, logDB *logdb.LogDB) error {
+// verifyLogDB verifies logs of blocks in range [startBlockNum, endBlockNum], blocks out of the retention window skipped.
+func verifyLogDB(ctx context.Context, startBlockNum, endBlockNum uint32, repo *chain.Repository, logDB logdb.LogDB) error {
 	fmt.Println(">> Verifying log db <<")
 	pb := pb.New64(int64(endBlockNum)).
 		Set64(0).
@@ -189,7 +192,15 @@ func verifyLogDB(ctx context.Context, endBlockNum uint32, repo *chain.Repository
 		}
 	)
 
-	for i := uint32(1); i <= endBlockNum; i++ {
+	if retainedFrom := logDB.RetainedFrom(); startBlockNum < retainedFrom {
+		startBlockNum = retainedFrom
+	}
+	if startBlockNum == 0 {
+		startBlockNum = 1
+	}
+	pb.Set64(int64(startBlockNum - 1))
+
+	for i := startBlockNum; i <= endBlockNum; i++ {
 		b, err := chain.GetBlock(i)
 		if err != nil {
 			return err
@@ -197,7 +208,7 @@ func verifyLogDB(ctx context.Context, endBlockNum uint32, repo *chain.Repository
 		id := b.Header().ID()
 
 		if i > logLimit {
-			logLimit += logStep
+			logLimit = i + logStep - 1
 			evLogs, err = logDB.FilterEvents(context.TODO(), &logdb.EventFilter{
 				Range: &logdb.Range{
 					From: i,
@@ -28,6 +28,7 @@ import (
 	"github.com/elastic/gosigar"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/discover"
@@ -326,16 +327,42 @@ func suggestFDCache() int {
 	return n
 }
 
-func openLogDB(ctx *cli.Context, dir string) (*logdb.LogDB, error) {
-	path := filepath.Join(dir, "logs.db")
+func openLogDB(ctx *cli.Context, dir string) (logdb.LogDB, error) {
+	readOnly := ctx.Bool(logsDBReadOnlyFlag.Name)
+	if url := ctx.String(logsDBURLFlag.Name); url != "" {
+		if readOnly {
+			db, err := logdb.NewPostgresReader(url)
+			if err != nil {
+				return nil, errors.Wrap(err, "open log database [postgres]")
+			}
+			return db, nil
+		}
+		db, err := logdb.NewPostgres(url)
+		if err != nil {
+			if err == logdb.ErrWriterLeased {
+				return nil, fmt.Errorf("%v, use flag %s to share it", err, logsDBReadOnlyFlag.Name)
+			}
+			// url not printed, it may contain the password
+			return nil, errors.Wrap(err, "open log database [postgres]")
+		}
+		db.RecordReverted(ctx.Bool(logsRevertedFlag.Name))
+		return db, nil
+	}
+
+	if readOnly {
+		return nil, fmt.Errorf("flag %s requires %s", logsDBReadOnlyFlag.Name, logsDBURLFlag.Name)
+	}
+	path := filepath.Join(dir, logDBFileName)
 	db, err := logdb.New(path)
 	if err != nil {
 		return nil, errors.Wrapf(err, "open log database [%v]", path)
 	}
+	db.RecordReverted(ctx.Bool(logsRevertedFlag.Name))
 	return db, nil
 }
 
-func initChainRepository(gene *genesis.Genesis, mainDB *muxdb.MuxDB, logDB *logdb.LogDB) (*chain.Repository, error) {
+// initChainRepository initializes the chain repository, and writes genesis logs if writeLogs.
+func initChainRepository(gene *genesis.Genesis, mainDB *muxdb.MuxDB, logDB logdb.LogDB, writeLogs bool) (*chain.Repository, error) {
 	genesisBlock, genesisEvents, genesisTransfers, err := gene.Build(state.NewStater(mainDB))
 	if err != nil {
 		return nil, errors.Wrap(err, "build genesis block")
@@ -345,6 +372,9 @@ func initChainRepository(gene *genesis.Genesis, mainDB *muxdb.MuxDB, logDB *logd
 	if err != nil {
 		return nil, errors.Wrap(err, "initialize block chain")
 	}
+	if !writeLogs {
+		return repo, nil
+	}
 	if err := logDB.Log(func(w *logdb.Writer) error {
 		return w.Write(genesisBlock, tx.Receipts{{
 			Outputs: []*tx.Output{
@@ -400,6 +430,68 @@ type p2pComm struct {
 	enode          string
 }
 
+func loadTxPoolAdmissionPolicy(ctx *cli.Context) (txpool.AdmissionPolicy, error) {
+	path := ctx.String(txPoolAdmissionRulesFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	policy, err := txpool.LoadAdmissionRules(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load tx pool admission rules [%v]", path)
+	}
+	return policy, nil
+}
+
+func loadTxPoolBlocklistOptions(ctx *cli.Context, options *txpool.Options, instanceDir string) error {
+	options.BlocklistFetchURLs = splitList(ctx.String(txPoolBlocklistURLsFlag.Name))
+	if len(options.BlocklistFetchURLs) == 0 {
+		// they apply to fetched blocklists only
+		for _, flag := range []cli.StringFlag{txPoolAllowlistFlag, txPoolBlocklistKeysFlag} {
+			if len(splitList(ctx.String(flag.Name))) > 0 {
+				return fmt.Errorf("flag %s requires %s", flag.Name, txPoolBlocklistURLsFlag.Name)
+			}
+		}
+		return nil
+	}
+	options.BlocklistCacheFilePath = filepath.Join(instanceDir, "tx.blocklist")
+
+	for _, s := range splitList(ctx.String(txPoolAllowlistFlag.Name)) {
+		addr, err := thor.ParseAddress(s)
+		if err != nil {
+			return errors.Wrapf(err, "parse tx pool allowlist [%v]", s)
+		}
+		options.BlocklistAllowlist = append(options.BlocklistAllowlist, addr)
+	}
+	for _, s := range splitList(ctx.String(txPoolBlocklistKeysFlag.Name)) {
+		data, err := hexutil.Decode(s)
+		if err != nil {
+			return errors.Wrapf(err, "parse tx pool blocklist key [%v]", s)
+		}
+		var key *ecdsa.PublicKey
+		if len(data) == 33 {
+			key, err = crypto.DecompressPubkey(data)
+		} else {
+			key, err = crypto.UnmarshalPubkey(data)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "parse tx pool blocklist key [%v]", s)
+		}
+		options.BlocklistPublicKeys = append(options.BlocklistPublicKeys, key)
+	}
+	return nil
+}
+
+// splitList splits the comma separated list, empty items are omitted.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func newP2PComm(ctx *cli.Context, repo *chain.Repository, txPool *txpool.TxPool, instanceDir string) (*p2pComm, error) {
 	configDir, err := makeConfigDir(ctx)
 	if err != nil {
@@ -555,7 +647,7 @@ func openMemMainDB() *muxdb.MuxDB {
 	return muxdb.NewMem()
 }
 
-func openMemLogDB() *logdb.LogDB {
+func openMemLogDB() logdb.LogDB {
 	db, err := logdb.NewMem()
 	if err != nil {
 		panic(errors.Wrap(err, "open log database"))
@@ -0,0 +1,133 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq" // postgres driver
+)
+
+var (
+	configColumns   = []string{"key", "value"}
+	eventColumns    = []string{"seq", "blockID", "blockTime", "txID", "txOrigin", "clauseIndex", "address", "topic0", "topic1", "topic2", "topic3", "topic4", "data"}
+	transferColumns = []string{"seq", "blockID", "blockTime", "txID", "txOrigin", "clauseIndex", "sender", "recipient", "amount"}
+	revertedColumns = []string{"seq", "blockID", "blockTime", "txID", "txOrigin", "clauseIndex", "target", "amount"}
+)
+
+// dialect describes how a database backend differs in SQL.
+// Statements are written with '?' placeholders, and rebound for the backend.
+type dialect struct {
+	driverName string
+	schema     string
+	postgres   bool
+}
+
+var (
+	sqliteDialect = &dialect{
+		driverName: driverName,
+		schema:     configTableSchema + refTableScheme + eventTableSchema + transferTableSchema + revertedTableSchema,
+	}
+	postgresDialect = &dialect{
+		driverName: "postgres",
+		schema:     postgresSchema,
+		postgres:   true,
+	}
+)
+
+// rebind replaces '?' placeholders with '$N' for postgres.
+func (d *dialect) rebind(query string) string {
+	if !d.postgres {
+		return query
+	}
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// existsQuery returns the query to count tables or indexes of the given name.
+func (d *dialect) existsQuery() string {
+	if d.postgres {
+		return "SELECT COUNT(*) FROM pg_class WHERE relname=$1"
+	}
+	return "SELECT COUNT(*) FROM sqlite_master WHERE name=?"
+}
+
+// insertOrIgnore builds the statement to insert rows, skipping rows conflicting with existing ones.
+// into is the table with optional column list, values the comma separated tuples.
+func (d *dialect) insertOrIgnore(into, values string) string {
+	if d.postgres {
+		return "INSERT INTO " + into + " VALUES" + values + " ON CONFLICT DO NOTHING"
+	}
+	return "INSERT OR IGNORE INTO " + into + " VALUES" + values
+}
+
+// insertOrReplace builds the statement to insert a row, replacing the existing one with the same key,
+// which is the first column.
+func (d *dialect) insertOrReplace(table string, columns []string, values string) string {
+	into := table + "(" + strings.Join(columns, ",") + ") VALUES(" + values + ")"
+	if d.postgres {
+		sets := make([]string, 0, len(columns)-1)
+		for _, c := range columns[1:] {
+			sets = append(sets, c+"=EXCLUDED."+c)
+		}
+		return "INSERT INTO " + into + " ON CONFLICT(" + columns[0] + ") DO UPDATE SET " + strings.Join(sets, ",")
+	}
+	return "INSERT OR REPLACE INTO " + into
+}
+
+// orderAfterJoin returns the ORDER BY clause to keep rows ordered after joining the ordered subquery.
+// sqlite keeps the order of the subquery, while postgres doesn't.
+func (d *dialect) orderAfterJoin(column string, order Order) string {
+	if !d.postgres {
+		return ""
+	}
+	if order == DESC {
+		return " ORDER BY " + column + " DESC"
+	}
+	return " ORDER BY " + column + " ASC"
+}
+
+// sumAmount returns the aggregate expression to sum transfer amounts.
+func (d *dialect) sumAmount() string {
+	if d.postgres {
+		return "SUM(amount)"
+	}
+	return "sum_amount(IFNULL(amount, X''))"
+}
+
+// amountValue converts the amount into the stored form.
+// sqlite stores big-endian bytes, postgres stores numeric.
+func (d *dialect) amountValue(amount *big.Int) interface{} {
+	if d.postgres {
+		return amount.String()
+	}
+	return amount.Bytes()
+}
+
+// parseAmount parses the amount in the stored form, or the sum of amounts.
+func (d *dialect) parseAmount(data []byte) *big.Int {
+	if d.postgres {
+		if amount, ok := new(big.Int).SetString(string(data), 10); ok {
+			return amount
+		}
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(data)
+}
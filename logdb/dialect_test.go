@@ -0,0 +1,229 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestDialect(t *testing.T) {
+	assert.Equal(t, "SELECT ? FROM ref WHERE data=?", sqliteDialect.rebind("SELECT ? FROM ref WHERE data=?"))
+	assert.Equal(t, "SELECT $1 FROM ref WHERE data=$2", postgresDialect.rebind("SELECT ? FROM ref WHERE data=?"))
+
+	assert.Equal(t, "INSERT OR IGNORE INTO ref(data) VALUES(?),(?)", sqliteDialect.insertOrIgnore("ref(data)", "(?),(?)"))
+	assert.Equal(t, "INSERT INTO ref(data) VALUES(?),(?) ON CONFLICT DO NOTHING", postgresDialect.insertOrIgnore("ref(data)", "(?),(?)"))
+
+	assert.Equal(t, "INSERT OR REPLACE INTO config(key,value) VALUES(?,?)", sqliteDialect.insertOrReplace("config", configColumns, "?,?"))
+	assert.Equal(t,
+		"INSERT INTO config(key,value) VALUES(?,?) ON CONFLICT(key) DO UPDATE SET value=EXCLUDED.value",
+		postgresDialect.insertOrReplace("config", configColumns, "?,?"))
+
+	assert.Equal(t, "SELECT COUNT(*) FROM sqlite_master WHERE name=?", sqliteDialect.existsQuery())
+	assert.Equal(t, "SELECT COUNT(*) FROM pg_class WHERE relname=$1", postgresDialect.existsQuery())
+
+	assert.Equal(t, "", sqliteDialect.orderAfterJoin("e.seq", DESC))
+	assert.Equal(t, " ORDER BY e.seq ASC", postgresDialect.orderAfterJoin("e.seq", ASC))
+	assert.Equal(t, " ORDER BY e.seq DESC", postgresDialect.orderAfterJoin("e.seq", DESC))
+
+	assert.Equal(t, "sum_amount(IFNULL(amount, X''))", sqliteDialect.sumAmount())
+	assert.Equal(t, "SUM(amount)", postgresDialect.sumAmount())
+
+	// sums of numeric are beyond 64 bits
+	sum, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	assert.Equal(t, sum, postgresDialect.parseAmount([]byte("123456789012345678901234567890")))
+	assert.Equal(t, new(big.Int), postgresDialect.parseAmount([]byte("NaN")))
+
+	amount := big.NewInt(1000)
+	for _, d := range []*dialect{sqliteDialect, postgresDialect} {
+		var stored []byte
+		switch v := d.amountValue(amount).(type) {
+		case []byte:
+			stored = v
+		case string:
+			stored = []byte(v)
+		}
+		assert.Equal(t, amount, d.parseAmount(stored))
+		assert.Equal(t, new(big.Int), d.parseAmount(nil))
+	}
+}
+
+func TestNeedsMigration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs.db")
+
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrating, err := needsMigration(db, sqliteDialect)
+	assert.Nil(t, err)
+	assert.False(t, migrating, "new db")
+
+	if _, err := db.Exec(sqliteDialect.schema); err != nil {
+		t.Fatal(err)
+	}
+	migrating, err = needsMigration(db, sqliteDialect)
+	assert.Nil(t, err)
+	assert.False(t, migrating, "up to date")
+
+	// the db created before indexes added
+	if _, err := db.Exec("DROP INDEX event_i7"); err != nil {
+		t.Fatal(err)
+	}
+	migrating, err = needsMigration(db, sqliteDialect)
+	assert.Nil(t, err)
+	assert.True(t, migrating)
+}
+
+// sqlRecorder is the driver to record statements instead of running them, to check the SQL generated for postgres.
+type sqlRecorder struct {
+	lock  sync.Mutex
+	stmts []recordedStmt
+}
+
+type recordedStmt struct {
+	query string
+	args  int
+}
+
+func (r *sqlRecorder) Open(name string) (driver.Conn, error) { return &sqlRecorderConn{r}, nil }
+
+type sqlRecorderConn struct {
+	recorder *sqlRecorder
+}
+
+func (c *sqlRecorderConn) Prepare(query string) (driver.Stmt, error) {
+	return &sqlRecorderStmt{c.recorder, query}, nil
+}
+func (c *sqlRecorderConn) Close() error              { return nil }
+func (c *sqlRecorderConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *sqlRecorderConn) Commit() error             { return nil }
+func (c *sqlRecorderConn) Rollback() error           { return nil }
+
+type sqlRecorderStmt struct {
+	recorder *sqlRecorder
+	query    string
+}
+
+func (s *sqlRecorderStmt) Close() error  { return nil }
+func (s *sqlRecorderStmt) NumInput() int { return -1 }
+func (s *sqlRecorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.record(args)
+	return driver.RowsAffected(0), nil
+}
+func (s *sqlRecorderStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.record(args)
+	return sqlRecorderRows{}, nil
+}
+
+func (s *sqlRecorderStmt) record(args []driver.Value) {
+	s.recorder.lock.Lock()
+	defer s.recorder.lock.Unlock()
+	s.recorder.stmts = append(s.recorder.stmts, recordedStmt{s.query, len(args)})
+}
+
+type sqlRecorderRows struct{}
+
+func (sqlRecorderRows) Columns() []string              { return nil }
+func (sqlRecorderRows) Close() error                   { return nil }
+func (sqlRecorderRows) Next(dest []driver.Value) error { return io.EOF }
+
+var postgresRecorder = &sqlRecorder{}
+
+func init() {
+	sql.Register("postgres_recorder_test", postgresRecorder)
+}
+
+func TestPostgresSQL(t *testing.T) {
+	conn, err := sql.Open("postgres_recorder_test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	db := &logDB{db: conn, dialect: postgresDialect}
+
+	var (
+		ctx  = context.Background()
+		addr = thor.BytesToAddress([]byte("addr"))
+		b    = new(block.Builder).ParentID(new(block.Builder).Build().Header().ID()).Transaction(new(tx.Builder).Build()).Build()
+	)
+	assert.Nil(t, db.Log(func(w *Writer) error {
+		return w.Write(b, tx.Receipts{{Outputs: []*tx.Output{{
+			Events:    tx.Events{{Address: addr, Topics: []thor.Bytes32{{1}, {2}}}},
+			Transfers: tx.Transfers{{Sender: addr, Recipient: addr, Amount: big.NewInt(1)}},
+		}}}})
+	}))
+	_, err = db.FilterEvents(ctx, &EventFilter{
+		CriteriaSet: []*EventCriteria{{Addresses: []thor.Address{addr}}, {Topics: [5][]thor.Bytes32{{{1}}}}},
+		Range:       &Range{From: 1, To: 10},
+		Options:     &Options{Limit: 10},
+		Order:       DESC,
+	})
+	assert.Nil(t, err)
+	_, err = db.FilterTransfers(ctx, &TransferFilter{CriteriaSet: []*TransferCriteria{{Sender: &addr}}, Options: &Options{Limit: 10}})
+	assert.Nil(t, err)
+	_, err = db.FilterReverted(ctx, &RevertedFilter{Options: &Options{Limit: 10}})
+	assert.Nil(t, err)
+	_, err = db.TransferStats(ctx, &TransferFilter{Range: &Range{From: 1, To: 10}}, GroupBySender, 0)
+	assert.Nil(t, err)
+	_, err = db.TransferStats(ctx, nil, GroupByBlock, 100)
+	assert.Nil(t, err)
+	// no rows from the recorder
+	_, err = db.HasBlockID(b.Header().ID())
+	assert.Equal(t, sql.ErrNoRows, err)
+	assert.Nil(t, db.Prune(ctx, 10))
+
+	placeholder := regexp.MustCompile(`\$(\d+)`)
+	queries := make(map[string]bool)
+	for _, stmt := range postgresRecorder.stmts {
+		queries[stmt.query] = true
+		assert.NotContains(t, stmt.query, "?")
+		// placeholders numbered from 1 for all args
+		max := 0
+		for _, m := range placeholder.FindAllStringSubmatch(stmt.query, -1) {
+			if n, _ := strconv.Atoi(m[1]); n > max {
+				max = n
+			}
+		}
+		assert.Equal(t, stmt.args, max, stmt.query)
+	}
+
+	contains := func(pattern string) bool {
+		re := regexp.MustCompile(pattern)
+		for q := range queries {
+			if re.MatchString(q) {
+				return true
+			}
+		}
+		return false
+	}
+	assert.True(t, contains(`^INSERT INTO ref\(data\) VALUES\(\$1\),\(\$2\).* ON CONFLICT DO NOTHING$`))
+	assert.True(t, contains(` ORDER BY e\.seq DESC$`), "events ordered after joined")
+	assert.True(t, contains(` ORDER BY t\.seq ASC$`), "transfers ordered after joined")
+	assert.True(t, contains(`SUM\(amount\)`))
+}
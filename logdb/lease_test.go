@@ -0,0 +1,174 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/tx"
+)
+
+// advisoryLockDriver mimics postgres advisory locks, which are held by sessions(connections) until closed.
+type advisoryLockDriver struct {
+	lock    sync.Mutex
+	holders map[int64]*advisoryLockConn
+}
+
+func (d *advisoryLockDriver) Open(name string) (driver.Conn, error) {
+	return &advisoryLockConn{driver: d}, nil
+}
+
+type advisoryLockConn struct {
+	driver *advisoryLockDriver
+}
+
+func (c *advisoryLockConn) Prepare(query string) (driver.Stmt, error) {
+	if query != "SELECT pg_try_advisory_lock($1)" {
+		return nil, errors.New("unexpected query: " + query)
+	}
+	return &advisoryLockStmt{conn: c}, nil
+}
+
+func (c *advisoryLockConn) Close() error {
+	c.driver.lock.Lock()
+	defer c.driver.lock.Unlock()
+	for key, holder := range c.driver.holders {
+		if holder == c {
+			delete(c.driver.holders, key)
+		}
+	}
+	return nil
+}
+
+func (c *advisoryLockConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type advisoryLockStmt struct {
+	conn *advisoryLockConn
+}
+
+func (s *advisoryLockStmt) Close() error  { return nil }
+func (s *advisoryLockStmt) NumInput() int { return 1 }
+func (s *advisoryLockStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *advisoryLockStmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	key := args[0].(int64)
+	holder, ok := d.holders[key]
+	if !ok {
+		d.holders[key] = s.conn
+	}
+	return &advisoryLockRows{acquired: !ok || holder == s.conn}, nil
+}
+
+type advisoryLockRows struct {
+	acquired bool
+	done     bool
+}
+
+func (r *advisoryLockRows) Columns() []string { return []string{"pg_try_advisory_lock"} }
+func (r *advisoryLockRows) Close() error      { return nil }
+func (r *advisoryLockRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.acquired
+	return nil
+}
+
+var advisoryLocks = &advisoryLockDriver{holders: make(map[int64]*advisoryLockConn)}
+
+func init() {
+	sql.Register("advisory_lock_test", advisoryLocks)
+}
+
+func TestWriterLease(t *testing.T) {
+	node1, err := sql.Open("advisory_lock_test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node1.Close()
+	node2, err := sql.Open("advisory_lock_test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node2.Close()
+
+	lease, err := acquireLease(node1)
+	assert.Nil(t, err)
+	advisoryLocks.lock.Lock()
+	assert.NotNil(t, advisoryLocks.holders[writerLeaseKey], "locked by the key")
+	advisoryLocks.lock.Unlock()
+
+	// only one writer
+	_, err = acquireLease(node2)
+	assert.Equal(t, ErrWriterLeased, err)
+	_, err = acquireLease(node2)
+	assert.Equal(t, ErrWriterLeased, err)
+
+	// released when the writer's db closed
+	assert.Nil(t, lease.Close())
+	assert.Nil(t, node1.Close())
+	lease, err = acquireLease(node2)
+	assert.Nil(t, err)
+	assert.Nil(t, lease.Close())
+}
+
+func TestReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs.db")
+
+	writer, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	reader, err := open(sqliteDialect, path+"?_journal=wal&cache=shared", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	b := new(block.Builder).Build()
+	for i := 0; i < 3; i++ {
+		b = new(block.Builder).ParentID(b.Header().ID()).Build()
+		assert.Nil(t, writer.Log(func(w *Writer) error {
+			return w.Write(b, tx.Receipts{})
+		}))
+	}
+	id, err := reader.NewestBlockID()
+	assert.Nil(t, err)
+	assert.Equal(t, b.Header().ID(), id)
+
+	assert.Equal(t, errReadOnly, reader.Log(func(w *Writer) error { return nil }))
+	assert.Equal(t, errReadOnly, reader.Prune(context.Background(), 2))
+
+	// pruned by the writer
+	assert.Nil(t, writer.Prune(context.Background(), 2))
+	assert.Equal(t, uint32(2), reader.RetainedFrom())
+}
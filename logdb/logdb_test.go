@@ -6,9 +6,14 @@
 package logdb_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
+	"database/sql"
+	"math"
 	"math/big"
+	"os"
+	"sort"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -56,6 +61,17 @@ func newReceipt() *tx.Receipt {
 	}
 }
 
+func orderByAddress(stats []*logdb.Stats) []*logdb.Stats {
+	sort.Slice(stats, func(i, j int) bool {
+		return bytes.Compare(stats[i].Address.Bytes(), stats[j].Address.Bytes()) < 0
+	})
+	return stats
+}
+
+func cursorOf(c uint64) *uint64 {
+	return &c
+}
+
 type eventLogs []*logdb.Event
 
 func (logs eventLogs) Filter(f func(ev *logdb.Event) bool) (ret eventLogs) {
@@ -99,6 +115,34 @@ func TestEvents(t *testing.T) {
 	}
 	defer db.Close()
 
+	testLogDB(t, db)
+}
+
+// TestEventsPostgres runs against the postgres given by env THOR_TEST_POSTGRES_URL, tables are dropped first.
+func TestEventsPostgres(t *testing.T) {
+	url := os.Getenv("THOR_TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("THOR_TEST_POSTGRES_URL not set")
+	}
+	conn, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.Exec("DROP TABLE IF EXISTS config, ref, event, transfer, reverted"); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := logdb.NewPostgres(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	testLogDB(t, db)
+}
+
+func testLogDB(t *testing.T, db logdb.LogDB) {
 	b := new(block.Builder).Build()
 
 	var allEvents eventLogs
@@ -108,6 +152,7 @@ func TestEvents(t *testing.T) {
 
 		b = new(block.Builder).
 			ParentID(b.Header().ID()).
+			Timestamp(b.Header().Timestamp() + 10).
 			Transaction(newTx()).
 			Transaction(newTx()).
 			Build()
@@ -161,13 +206,34 @@ func TestEvents(t *testing.T) {
 			{"query all events asc", &logdb.EventFilter{Order: logdb.ASC}, allEvents},
 			{"query all events desc", &logdb.EventFilter{Order: logdb.DESC}, allEvents.Reverse()},
 			{"query all events limit offset", &logdb.EventFilter{Options: &logdb.Options{Offset: 1, Limit: 10}}, allEvents[1:11]},
+			{"query all events time range", &logdb.EventFilter{TimeRange: &logdb.TimeRange{From: 105, To: 200}}, allEvents.Filter(func(ev *logdb.Event) bool { return ev.BlockTime >= 105 && ev.BlockTime <= 200 })},
+			{"query all events block and time range", &logdb.EventFilter{Range: &logdb.Range{From: 15, To: 30}, TimeRange: &logdb.TimeRange{From: 100, To: 200}}, allEvents.Filter(func(ev *logdb.Event) bool { return ev.BlockNumber >= 15 && ev.BlockTime <= 200 })},
+			{"query all events time range out of bound", &logdb.EventFilter{TimeRange: &logdb.TimeRange{From: 2000, To: 3000}}, eventLogs(nil)},
+			{"query all events cursor", &logdb.EventFilter{Cursor: cursorOf(allEvents[10].Cursor()), Options: &logdb.Options{Limit: 10}}, allEvents[11:21]},
+			{"query all events cursor desc", &logdb.EventFilter{Cursor: cursorOf(allEvents[10].Cursor()), Order: logdb.DESC}, allEvents[:10].Reverse()},
 			{"query all events range", &logdb.EventFilter{Range: &logdb.Range{From: 10, To: 20}}, allEvents.Filter(func(ev *logdb.Event) bool { return ev.BlockNumber >= 10 && ev.BlockNumber <= 20 })},
-			{"query all events with criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: &allEvents[1].Address}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+			{"query all events with criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Addresses: []thor.Address{allEvents[1].Address}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
 				return ev.Address == allEvents[1].Address
 			})},
-			{"query all events with multi-criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Address: &allEvents[1].Address}, {Topics: [5]*thor.Bytes32{allEvents[2].Topics[0]}}, {Topics: [5]*thor.Bytes32{allEvents[3].Topics[0]}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+			{"query all events with multi-criteria", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Addresses: []thor.Address{allEvents[1].Address}}, {Topics: [5][]thor.Bytes32{{*allEvents[2].Topics[0]}}}, {Topics: [5][]thor.Bytes32{{*allEvents[3].Topics[0]}}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
 				return ev.Address == allEvents[1].Address || *ev.Topics[0] == *allEvents[2].Topics[0] || *ev.Topics[0] == *allEvents[3].Topics[0]
 			})},
+			{"query all events with txID", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{TxID: &allEvents[1].TxID}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+				return ev.TxID == allEvents[1].TxID
+			})},
+			{"query all events with txOrigin", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{TxOrigin: &allEvents[1].TxOrigin}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+				return ev.TxOrigin == allEvents[1].TxOrigin
+			})},
+			{"query all events with txOrigin and address", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{TxOrigin: &allEvents[1].TxOrigin, Addresses: []thor.Address{allEvents[2].Address}}}}, eventLogs(nil)},
+			{"query all events with address set", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{Addresses: []thor.Address{allEvents[1].Address, allEvents[5].Address, randAddress()}}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+				return ev.Address == allEvents[1].Address || ev.Address == allEvents[5].Address
+			})},
+			{"query all events with address and topic sets", &logdb.EventFilter{CriteriaSet: []*logdb.EventCriteria{{
+				Addresses: []thor.Address{allEvents[1].Address, allEvents[5].Address, allEvents[7].Address},
+				Topics:    [5][]thor.Bytes32{{*allEvents[1].Topics[0], *allEvents[7].Topics[0], *allEvents[9].Topics[0]}},
+			}}}, allEvents.Filter(func(ev *logdb.Event) bool {
+				return ev.Address == allEvents[1].Address || ev.Address == allEvents[7].Address
+			})},
 		}
 
 		for _, tt := range tests {
@@ -175,6 +241,18 @@ func TestEvents(t *testing.T) {
 				got, err := db.FilterEvents(context.Background(), tt.arg)
 				assert.Nil(t, err)
 				assert.Equal(t, tt.want, eventLogs(got))
+
+				if len(tt.arg.CriteriaSet) > 0 && tt.arg.Range == nil && tt.arg.TimeRange == nil {
+					// criteria matched in memory should be consistent with db
+					assert.Equal(t, tt.want, allEvents.Filter(func(ev *logdb.Event) bool {
+						for _, c := range tt.arg.CriteriaSet {
+							if c.Match(ev) {
+								return true
+							}
+						}
+						return false
+					}))
+				}
 			})
 		}
 	}
@@ -189,6 +267,10 @@ func TestEvents(t *testing.T) {
 			{"query all transfers asc", &logdb.TransferFilter{Order: logdb.ASC}, allTransfers},
 			{"query all transfers desc", &logdb.TransferFilter{Order: logdb.DESC}, allTransfers.Reverse()},
 			{"query all transfers limit offset", &logdb.TransferFilter{Options: &logdb.Options{Offset: 1, Limit: 10}}, allTransfers[1:11]},
+			{"query all transfers time range", &logdb.TransferFilter{TimeRange: &logdb.TimeRange{From: 105, To: 200}}, allTransfers.Filter(func(tr *logdb.Transfer) bool { return tr.BlockTime >= 105 && tr.BlockTime <= 200 })},
+			{"query all transfers time range desc", &logdb.TransferFilter{TimeRange: &logdb.TimeRange{From: 0, To: 55}, Order: logdb.DESC}, allTransfers.Filter(func(tr *logdb.Transfer) bool { return tr.BlockTime <= 55 }).Reverse()},
+			{"query all transfers cursor", &logdb.TransferFilter{Cursor: cursorOf(allTransfers[10].Cursor()), Options: &logdb.Options{Limit: 10}}, allTransfers[11:21]},
+			{"query all transfers cursor desc", &logdb.TransferFilter{Cursor: cursorOf(allTransfers[10].Cursor()), Order: logdb.DESC}, allTransfers[:10].Reverse()},
 			{"query all transfers range", &logdb.TransferFilter{Range: &logdb.Range{From: 10, To: 20}}, allTransfers.Filter(func(tr *logdb.Transfer) bool { return tr.BlockNumber >= 10 && tr.BlockNumber <= 20 })},
 			{"query all transfers with criteria", &logdb.TransferFilter{CriteriaSet: []*logdb.TransferCriteria{{Sender: &allTransfers[1].Sender}}}, allTransfers.Filter(func(tr *logdb.Transfer) bool {
 				return tr.Sender == allTransfers[1].Sender
@@ -196,6 +278,12 @@ func TestEvents(t *testing.T) {
 			{"query all transfers with multi-criteria", &logdb.TransferFilter{CriteriaSet: []*logdb.TransferCriteria{{Sender: &allTransfers[1].Sender}, {Recipient: &allTransfers[2].Recipient}}}, allTransfers.Filter(func(tr *logdb.Transfer) bool {
 				return tr.Sender == allTransfers[1].Sender || tr.Recipient == allTransfers[2].Recipient
 			})},
+			{"query all transfers with txID", &logdb.TransferFilter{CriteriaSet: []*logdb.TransferCriteria{{TxID: &allTransfers[1].TxID}}}, allTransfers.Filter(func(tr *logdb.Transfer) bool {
+				return tr.TxID == allTransfers[1].TxID
+			})},
+			{"query all transfers with txOrigin", &logdb.TransferFilter{CriteriaSet: []*logdb.TransferCriteria{{TxOrigin: &allTransfers[1].TxOrigin}}}, allTransfers.Filter(func(tr *logdb.Transfer) bool {
+				return tr.TxOrigin == allTransfers[1].TxOrigin
+			})},
 		}
 
 		for _, tt := range tests {
@@ -206,4 +294,163 @@ func TestEvents(t *testing.T) {
 			})
 		}
 	}
+
+	{
+		// stats grouped by block range bucket
+		var (
+			wantEvents    []*logdb.Stats
+			wantTransfers []*logdb.Stats
+		)
+		for i, tr := range allTransfers {
+			bucket := tr.BlockNumber / 10
+			if len(wantTransfers) == 0 || wantTransfers[len(wantTransfers)-1].Range.From != bucket*10 {
+				rng := &logdb.Range{From: bucket * 10, To: bucket*10 + 9}
+				wantEvents = append(wantEvents, &logdb.Stats{Range: rng})
+				wantTransfers = append(wantTransfers, &logdb.Stats{Range: rng, Amount: new(big.Int)})
+			}
+			wantEvents[len(wantEvents)-1].Count++
+			last := wantTransfers[len(wantTransfers)-1]
+			last.Count++
+			last.Amount.Add(last.Amount, allTransfers[i].Amount)
+		}
+
+		got, err := db.EventStats(context.Background(), &logdb.EventFilter{}, logdb.GroupByBlock, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, wantEvents, got)
+
+		gotTransfers, err := db.TransferStats(context.Background(), &logdb.TransferFilter{}, logdb.GroupByBlock, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, wantTransfers, gotTransfers)
+
+		gotTransfers, err = db.TransferStats(context.Background(), &logdb.TransferFilter{Order: logdb.DESC, Options: &logdb.Options{Limit: 1}}, logdb.GroupByBlock, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, wantTransfers[len(wantTransfers)-1:], gotTransfers)
+
+		// one bucket for all
+		got, err = db.EventStats(context.Background(), nil, logdb.GroupByBlock, math.MaxUint32)
+		assert.Nil(t, err)
+		assert.Equal(t, []*logdb.Stats{{Range: &logdb.Range{From: 0, To: math.MaxUint32 - 1}, Count: uint64(len(allEvents))}}, got)
+
+		_, err = db.EventStats(context.Background(), nil, logdb.GroupByBlock, 0)
+		assert.NotNil(t, err, "zero bucket size")
+		_, err = db.EventStats(context.Background(), nil, logdb.GroupBySender, 0)
+		assert.NotNil(t, err, "unsupported group")
+	}
+
+	{
+		// stats grouped by address
+		sender := allTransfers[3].Sender
+		got, err := db.TransferStats(context.Background(), &logdb.TransferFilter{
+			CriteriaSet: []*logdb.TransferCriteria{{Sender: &sender}, {TxID: &allTransfers[5].TxID}},
+		}, logdb.GroupBySender, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, orderByAddress([]*logdb.Stats{
+			{Address: &allTransfers[3].Sender, Count: 1, Amount: allTransfers[3].Amount},
+			{Address: &allTransfers[5].Sender, Count: 1, Amount: allTransfers[5].Amount},
+		}), orderByAddress(got))
+
+		evStats, err := db.EventStats(context.Background(), &logdb.EventFilter{Options: &logdb.Options{Limit: 5}}, logdb.GroupByAddress, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(evStats))
+		for _, st := range evStats {
+			assert.Equal(t, uint64(1), st.Count)
+			assert.Nil(t, st.Amount)
+		}
+	}
+}
+
+func TestReverted(t *testing.T) {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	newRevertedTx := func(to thor.Address, value int64) *tx.Transaction {
+		tx := new(tx.Builder).
+			Clause(tx.NewClause(&to).WithValue(big.NewInt(value))).
+			Clause(tx.NewClause(nil)).
+			Build()
+		pk, _ := crypto.GenerateKey()
+		sig, _ := crypto.Sign(tx.Hash().Bytes(), pk)
+		return tx.WithSignature(sig)
+	}
+	target := randAddress()
+
+	b0 := new(block.Builder).Build()
+	b1 := new(block.Builder).
+		ParentID(b0.Header().ID()).
+		Transaction(newRevertedTx(target, 1)).
+		Build()
+	b2 := new(block.Builder).
+		ParentID(b1.Header().ID()).
+		Transaction(newTx()).
+		Transaction(newRevertedTx(target, 2)).
+		Build()
+
+	// not recorded by default
+	assert.Nil(t, db.Log(func(w *logdb.Writer) error {
+		return w.Write(b1, tx.Receipts{{Reverted: true}})
+	}))
+	got, err := db.FilterReverted(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(got))
+
+	db.RecordReverted(true)
+	assert.Nil(t, db.Log(func(w *logdb.Writer) error {
+		if err := w.Write(b1, tx.Receipts{{Reverted: true}}); err != nil {
+			return err
+		}
+		return w.Write(b2, tx.Receipts{newReceipt(), {Reverted: true}})
+	}))
+
+	// 2 clauses per tx
+	got, err = db.FilterReverted(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(got))
+
+	// block with attempts of reverted txs only
+	has, err := db.HasBlockID(b1.Header().ID())
+	assert.Nil(t, err)
+	assert.True(t, has)
+
+	revertedTx := b2.Transactions()[1]
+	origin, _ := revertedTx.Origin()
+	got, err = db.FilterReverted(context.Background(), &logdb.RevertedFilter{
+		CriteriaSet: []*logdb.RevertedCriteria{{Target: &target}},
+		Order:       logdb.DESC,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*logdb.Reverted{
+		{
+			BlockNumber: b2.Header().Number(),
+			Index:       0,
+			BlockID:     b2.Header().ID(),
+			BlockTime:   b2.Header().Timestamp(),
+			TxID:        revertedTx.ID(),
+			TxOrigin:    origin,
+			ClauseIndex: 0,
+			Target:      &target,
+			Amount:      big.NewInt(2),
+		},
+		{
+			BlockNumber: b1.Header().Number(),
+			Index:       0,
+			BlockID:     b1.Header().ID(),
+			BlockTime:   b1.Header().Timestamp(),
+			TxID:        b1.Transactions()[0].ID(),
+			TxOrigin:    func() thor.Address { o, _ := b1.Transactions()[0].Origin(); return o }(),
+			ClauseIndex: 0,
+			Target:      &target,
+			Amount:      big.NewInt(1),
+		},
+	}, got)
+
+	got, err = db.FilterReverted(context.Background(), &logdb.RevertedFilter{
+		CriteriaSet: []*logdb.RevertedCriteria{{TxOrigin: &origin}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(got))
+	assert.Nil(t, got[1].Target, "contract creation")
+	assert.Equal(t, uint32(1), got[1].Index)
 }
@@ -0,0 +1,203 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// number of blocks whose logs are deleted in one transaction
+const pruneBatchSize = 1000
+
+// number of refs swept in one statement
+var sweepBatchSize = 500
+
+// RetainedFrom returns the first block number whose logs are retained.
+// Logs of blocks before it have been pruned.
+func (db *logDB) RetainedFrom() uint32 {
+	if db.readOnly {
+		// pruned by the writer
+		if retainedFrom, err := loadRetainedFrom(db.db, db.dialect); err == nil {
+			atomic.StoreUint32(&db.retainedFrom, retainedFrom)
+		}
+	}
+	return atomic.LoadUint32(&db.retainedFrom)
+}
+
+// Prune deletes logs of blocks before the given block number, and then refs no longer referenced.
+// Logs are deleted in batches, to not block writing for long.
+// Refs of logs pruned before an interruption are left unswept.
+func (db *logDB) Prune(ctx context.Context, before uint32) error {
+	if db.readOnly {
+		return errReadOnly
+	}
+	from := db.RetainedFrom()
+	if before <= from {
+		return nil
+	}
+
+	for from < before {
+		to := before
+		if to-from > pruneBatchSize {
+			to = from + pruneBatchSize
+		}
+		refs, err := db.pruneBefore(to)
+		if err != nil {
+			return err
+		}
+		if err := db.sweepRefs(ctx, refs); err != nil {
+			return err
+		}
+		from = to
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+	return nil
+}
+
+// pruneBefore deletes logs of blocks before the given block number, and returns ids of refs they referenced.
+func (db *logDB) pruneBefore(blockNum uint32) (refs []int64, err error) {
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	tx, err := db.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	seq := newSequence(blockNum, 0)
+	collected := make(map[int64]bool)
+	for _, table := range refTables {
+		if err = collectRefs(tx, db.dialect, table, seq, collected); err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(db.dialect.rebind("DELETE FROM "+table.name+" WHERE seq < ?"), seq); err != nil {
+			return nil, err
+		}
+	}
+
+	var value [4]byte
+	binary.BigEndian.PutUint32(value[:], blockNum)
+	if _, err = tx.Exec(
+		db.dialect.rebind(db.dialect.insertOrReplace("config", configColumns, "?,?")),
+		configRetainedFromKey, value[:]); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	atomic.StoreUint32(&db.retainedFrom, blockNum)
+
+	refs = make([]int64, 0, len(collected))
+	for id := range collected {
+		refs = append(refs, id)
+	}
+	return refs, nil
+}
+
+// refTable is a log table with columns referencing refs.
+type refTable struct {
+	name    string
+	columns []string
+}
+
+// tables referencing refs. Block ids are referenced only by logs of the block, which are pruned together,
+// so blockID columns, which are not indexed, need not be checked when sweeping.
+var refTables = []refTable{
+	{"event", []string{"blockID", "txID", "txOrigin", "address", "topic0", "topic1", "topic2", "topic3", "topic4"}},
+	{"transfer", []string{"blockID", "txID", "txOrigin", "sender", "recipient"}},
+	{"reverted", []string{"blockID", "txID", "txOrigin", "target"}},
+}
+
+// collectRefs collects ids of refs referenced by logs before the seq.
+func collectRefs(tx *sql.Tx, dialect *dialect, table refTable, seq sequence, collected map[int64]bool) error {
+	rows, err := tx.Query(dialect.rebind(
+		"SELECT "+strings.Join(table.columns, ",")+" FROM "+table.name+" WHERE seq < ?"), seq)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	ids := make([]sql.NullInt64, len(table.columns))
+	dest := make([]interface{}, len(ids))
+	for i := range ids {
+		dest[i] = &ids[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if id.Valid {
+				collected[id.Int64] = true
+			}
+		}
+	}
+	return rows.Err()
+}
+
+// sweepRefs deletes refs of the given ids, which are not referenced by any event, transfer or reverted attempt.
+// Refs are swept in batches, to not block writing for long.
+func (db *logDB) sweepRefs(ctx context.Context, refs []int64) error {
+	for len(refs) > 0 {
+		n := len(refs)
+		if n > sweepBatchSize {
+			n = sweepBatchSize
+		}
+		args := make([]interface{}, n)
+		for i, id := range refs[:n] {
+			args[i] = id
+		}
+		refs = refs[n:]
+
+		if err := db.sweepRefsBatch(db.dialect.rebind(sweepQuery(n)), args); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+	return nil
+}
+
+// sweepQuery builds the statement to delete refs of n ids, if not referenced.
+// Referencing columns are checked by indexes.
+func sweepQuery(n int) string {
+	var conds []string
+	for _, table := range refTables {
+		for _, column := range table.columns {
+			if column != "blockID" {
+				conds = append(conds, fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %v WHERE %v=ref.id)", table.name, column))
+			}
+		}
+	}
+	return "DELETE FROM ref WHERE id IN (?" + strings.Repeat(",?", n-1) + ") AND " + strings.Join(conds, " AND ")
+}
+
+func (db *logDB) sweepRefsBatch(query string, args []interface{}) error {
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	_, err := db.db.Exec(query, args...)
+	return err
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs.db")
+
+	db, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	countRefs := func() (n int) {
+		assert.Nil(t, db.(*logDB).db.QueryRow("SELECT COUNT(*) FROM ref").Scan(&n))
+		return
+	}
+	hasRef := func(data []byte) bool {
+		var n int
+		assert.Nil(t, db.(*logDB).db.QueryRow("SELECT COUNT(*) FROM ref WHERE data=?", data).Scan(&n))
+		return n > 0
+	}
+	recipient := func(i int) thor.Address {
+		return thor.BytesToAddress(big.NewInt(int64(i + 1)).Bytes())
+	}
+
+	// blocks i and i+1 share a topic, if i is even
+	topic := func(i int) thor.Bytes32 {
+		return thor.BytesToBytes32(big.NewInt(int64(i / 2)).Bytes())
+	}
+
+	shared := thor.BytesToAddress([]byte("shared"))
+	b := new(block.Builder).Build()
+	var crossID thor.Bytes32 // id of the last pruned block, referenced by a retained block
+	for i := 0; i < 2500; i++ {
+		parentID := b.Header().ID()
+		b = new(block.Builder).
+			ParentID(parentID).
+			Transaction(new(tx.Builder).Nonce(uint64(i)).Build()).
+			Build()
+		topics := []thor.Bytes32{topic(i)}
+		if i == 1999 {
+			crossID = parentID
+			topics = append(topics, parentID)
+		}
+		receipts := tx.Receipts{{
+			Outputs: []*tx.Output{{
+				Events: tx.Events{{
+					Address: shared,
+					Topics:  topics,
+				}},
+				Transfers: tx.Transfers{{
+					Sender:    shared,
+					Recipient: recipient(i),
+					Amount:    big.NewInt(1),
+				}},
+			}},
+		}}
+		if err := db.Log(func(w *Writer) error {
+			return w.Write(b, receipts)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, uint32(0), db.RetainedFrom())
+	refs := countRefs()
+
+	// nothing to prune
+	assert.Nil(t, db.Prune(context.Background(), 0))
+	assert.Equal(t, refs, countRefs())
+
+	// sweep refs in many batches
+	defer func(n int) { sweepBatchSize = n }(sweepBatchSize)
+	sweepBatchSize = 100
+
+	assert.Nil(t, db.Prune(context.Background(), 2001))
+	assert.Equal(t, uint32(2001), db.RetainedFrom())
+
+	// blocks are numbered from 2 to 2501
+	events, err := db.FilterEvents(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 501, len(events))
+	assert.Equal(t, uint32(2001), events[0].BlockNumber)
+
+	transfers, err := db.FilterTransfers(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 501, len(transfers))
+	assert.Equal(t, shared, transfers[0].Sender)
+
+	// refs of pruned blocks are swept, those shared with retained blocks kept
+	assert.True(t, countRefs() < refs)
+	assert.False(t, hasRef(recipient(1998).Bytes()))
+	assert.True(t, hasRef(recipient(1999).Bytes()))
+	assert.True(t, hasRef(shared.Bytes()))
+	assert.False(t, hasRef(topic(1997).Bytes()))
+	assert.True(t, hasRef(topic(1998).Bytes()), "shared across the cut-off block")
+	assert.True(t, hasRef(crossID.Bytes()), "pruned block id referenced as topic")
+
+	// sweeping is cancelable
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, db.(*logDB).sweepRefs(ctx, []int64{1}))
+
+	// retained from is persisted
+	db.Close()
+	db, err = New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	assert.Equal(t, uint32(2001), db.RetainedFrom())
+}
+
+func TestSweepQueryPlan(t *testing.T) {
+	db, err := NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.(*logDB).db.Query("EXPLAIN QUERY PLAN "+sweepQuery(2), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var n int
+	for rows.Next() {
+		var (
+			id, parent, notUsed int
+			detail              string
+		)
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			t.Fatal(err)
+		}
+		// tables are searched by indexes, never scanned
+		assert.NotContains(t, detail, "SCAN ")
+		n++
+	}
+	assert.Nil(t, rows.Err())
+	assert.NotZero(t, n)
+}
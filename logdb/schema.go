@@ -37,7 +37,11 @@ CREATE INDEX IF NOT EXISTS event_i0 ON event(address);
 CREATE INDEX IF NOT EXISTS event_i1 ON event(topic0, address);
 CREATE INDEX IF NOT EXISTS event_i2 ON event(topic1, topic0, address) WHERE topic1 IS NOT NULL;
 CREATE INDEX IF NOT EXISTS event_i3 ON event(topic2, topic0, address) WHERE topic2 IS NOT NULL;
-CREATE INDEX IF NOT EXISTS event_i4 ON event(topic3, topic0, address) WHERE topic3 IS NOT NULL;`
+CREATE INDEX IF NOT EXISTS event_i4 ON event(topic3, topic0, address) WHERE topic3 IS NOT NULL;
+CREATE INDEX IF NOT EXISTS event_i5 ON event(txID);
+CREATE INDEX IF NOT EXISTS event_i6 ON event(txOrigin);
+CREATE INDEX IF NOT EXISTS event_i7 ON event(blockTime);
+CREATE INDEX IF NOT EXISTS event_i8 ON event(topic4) WHERE topic4 IS NOT NULL;`
 
 	// create a table for transfer
 	transferTableSchema = `CREATE TABLE IF NOT EXISTS transfer (
@@ -54,5 +58,98 @@ CREATE INDEX IF NOT EXISTS event_i4 ON event(topic3, topic0, address) WHERE topi
 
 CREATE INDEX IF NOT EXISTS transfer_i0 ON transfer(txOrigin);
 CREATE INDEX IF NOT EXISTS transfer_i1 ON transfer(sender);
-CREATE INDEX IF NOT EXISTS transfer_i2 ON transfer(recipient);`
+CREATE INDEX IF NOT EXISTS transfer_i2 ON transfer(recipient);
+CREATE INDEX IF NOT EXISTS transfer_i3 ON transfer(txID);
+CREATE INDEX IF NOT EXISTS transfer_i4 ON transfer(blockTime);`
+
+	// create a table for attempts of reverted txs
+	revertedTableSchema = `CREATE TABLE IF NOT EXISTS reverted (
+	seq INTEGER PRIMARY KEY NOT NULL,
+	blockID	INTEGER NOT NULL,
+	blockTime INTEGER NOT NULL,
+	txID INTEGER NOT NULL,
+	txOrigin INTEGER NOT NULL,
+	clauseIndex INTEGER NOT NULL,
+	target INTEGER,
+	amount BLOB(32)
+);
+
+CREATE INDEX IF NOT EXISTS reverted_i0 ON reverted(txOrigin);
+CREATE INDEX IF NOT EXISTS reverted_i1 ON reverted(target) WHERE target IS NOT NULL;
+CREATE INDEX IF NOT EXISTS reverted_i2 ON reverted(txID);
+CREATE INDEX IF NOT EXISTS reverted_i3 ON reverted(blockTime);`
 )
+
+// indexes added to tables of existing log dbs, which take long to build on large dbs
+var migratedIndexes = []string{"event_i5", "event_i6", "event_i7", "event_i8", "transfer_i3", "transfer_i4"}
+
+// the schema for postgres, in which refs and amounts are stored in BYTEA and NUMERIC
+const postgresSchema = `CREATE TABLE IF NOT EXISTS config (
+	key VARCHAR(20) PRIMARY KEY,
+	value BYTEA
+);
+
+CREATE TABLE IF NOT EXISTS ref (
+	id BIGSERIAL PRIMARY KEY,
+	data BYTEA NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS event (
+	seq BIGINT PRIMARY KEY,
+	blockID BIGINT NOT NULL,
+	blockTime BIGINT NOT NULL,
+	txID BIGINT NOT NULL,
+	txOrigin BIGINT NOT NULL,
+	clauseIndex INTEGER NOT NULL,
+	address BIGINT NOT NULL,
+	topic0 BIGINT,
+	topic1 BIGINT,
+	topic2 BIGINT,
+	topic3 BIGINT,
+	topic4 BIGINT,
+	data BYTEA
+);
+
+CREATE INDEX IF NOT EXISTS event_i0 ON event(address);
+CREATE INDEX IF NOT EXISTS event_i1 ON event(topic0, address);
+CREATE INDEX IF NOT EXISTS event_i2 ON event(topic1, topic0, address) WHERE topic1 IS NOT NULL;
+CREATE INDEX IF NOT EXISTS event_i3 ON event(topic2, topic0, address) WHERE topic2 IS NOT NULL;
+CREATE INDEX IF NOT EXISTS event_i4 ON event(topic3, topic0, address) WHERE topic3 IS NOT NULL;
+CREATE INDEX IF NOT EXISTS event_i5 ON event(txID);
+CREATE INDEX IF NOT EXISTS event_i6 ON event(txOrigin);
+CREATE INDEX IF NOT EXISTS event_i7 ON event(blockTime);
+CREATE INDEX IF NOT EXISTS event_i8 ON event(topic4) WHERE topic4 IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS transfer (
+	seq BIGINT PRIMARY KEY,
+	blockID BIGINT NOT NULL,
+	blockTime BIGINT NOT NULL,
+	txID BIGINT NOT NULL,
+	txOrigin BIGINT NOT NULL,
+	clauseIndex INTEGER NOT NULL,
+	sender BIGINT NOT NULL,
+	recipient BIGINT NOT NULL,
+	amount NUMERIC(78)
+);
+
+CREATE INDEX IF NOT EXISTS transfer_i0 ON transfer(txOrigin);
+CREATE INDEX IF NOT EXISTS transfer_i1 ON transfer(sender);
+CREATE INDEX IF NOT EXISTS transfer_i2 ON transfer(recipient);
+CREATE INDEX IF NOT EXISTS transfer_i3 ON transfer(txID);
+CREATE INDEX IF NOT EXISTS transfer_i4 ON transfer(blockTime);
+
+CREATE TABLE IF NOT EXISTS reverted (
+	seq BIGINT PRIMARY KEY,
+	blockID BIGINT NOT NULL,
+	blockTime BIGINT NOT NULL,
+	txID BIGINT NOT NULL,
+	txOrigin BIGINT NOT NULL,
+	clauseIndex INTEGER NOT NULL,
+	target BIGINT,
+	amount NUMERIC(78)
+);
+
+CREATE INDEX IF NOT EXISTS reverted_i0 ON reverted(txOrigin);
+CREATE INDEX IF NOT EXISTS reverted_i1 ON reverted(target) WHERE target IS NOT NULL;
+CREATE INDEX IF NOT EXISTS reverted_i2 ON reverted(txID);
+CREATE INDEX IF NOT EXISTS reverted_i3 ON reverted(blockTime);`
@@ -0,0 +1,182 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/vechain/thor/thor"
+)
+
+// GroupBy defines how logs are grouped when aggregating.
+type GroupBy string
+
+const (
+	GroupByBlock     GroupBy = "block"     // by block range bucket
+	GroupByAddress   GroupBy = "address"   // by event emitter
+	GroupBySender    GroupBy = "sender"    // by transfer sender
+	GroupByRecipient GroupBy = "recipient" // by transfer recipient
+)
+
+// Stats is the aggregation of a group of logs.
+type Stats struct {
+	Range   *Range        // the block range bucket, if grouped by block
+	Address *thor.Address // the grouping address, if grouped by address, sender or recipient
+	Count   uint64
+	Amount  *big.Int // sum of transfer amounts, nil for events
+}
+
+// amountSum is the sqlite aggregator to sum amounts stored in big-endian bytes.
+type amountSum struct {
+	sum big.Int
+}
+
+func newAmountSum() *amountSum {
+	return &amountSum{}
+}
+
+func (s *amountSum) Step(amount []byte) {
+	s.sum.Add(&s.sum, new(big.Int).SetBytes(amount))
+}
+
+func (s *amountSum) Done() []byte {
+	return s.sum.Bytes()
+}
+
+// EventStats counts events matching the filter by groups.
+// The filter's options page the groups.
+func (db *logDB) EventStats(ctx context.Context, filter *EventFilter, groupBy GroupBy, bucketSize uint32) ([]*Stats, error) {
+	if filter == nil {
+		filter = &EventFilter{}
+	}
+	var column string
+	switch groupBy {
+	case GroupByBlock:
+	case GroupByAddress:
+		column = "address"
+	default:
+		return nil, fmt.Errorf("unsupported group %q for events", groupBy)
+	}
+	cond, args := filter.toWhereCondition()
+	return db.queryStats(ctx, "event", cond, args, column, bucketSize, filter.Order, filter.Options)
+}
+
+// TransferStats counts transfers and sums transferred amounts matching the filter by groups.
+// The filter's options page the groups.
+func (db *logDB) TransferStats(ctx context.Context, filter *TransferFilter, groupBy GroupBy, bucketSize uint32) ([]*Stats, error) {
+	if filter == nil {
+		filter = &TransferFilter{}
+	}
+	var column string
+	switch groupBy {
+	case GroupByBlock:
+	case GroupBySender:
+		column = "sender"
+	case GroupByRecipient:
+		column = "recipient"
+	default:
+		return nil, fmt.Errorf("unsupported group %q for transfers", groupBy)
+	}
+	cond, args := filter.toWhereCondition()
+	return db.queryStats(ctx, "transfer", cond, args, column, bucketSize, filter.Order, filter.Options)
+}
+
+// queryStats aggregates rows of the table by the address column, or by block range bucket if column is empty.
+func (db *logDB) queryStats(
+	ctx context.Context,
+	table string,
+	cond string,
+	args []interface{},
+	column string,
+	bucketSize uint32,
+	order Order,
+	options *Options,
+) ([]*Stats, error) {
+	amount := "NULL"
+	if table == "transfer" {
+		amount = db.dialect.sumAmount()
+	}
+
+	var (
+		query     string
+		queryArgs []interface{}
+	)
+	if column == "" {
+		if bucketSize == 0 {
+			return nil, errors.New("zero bucket size")
+		}
+		dir := "ASC"
+		if order == DESC {
+			dir = "DESC"
+		}
+		query = fmt.Sprintf("SELECT (seq >> 31) / ? AS k, NULL, COUNT(*), %v FROM %v WHERE %v GROUP BY k ORDER BY k %v",
+			amount, table, cond, dir)
+		queryArgs = append(append(queryArgs, bucketSize), args...)
+	} else {
+		// groups with more logs come first
+		query = fmt.Sprintf("SELECT %v AS k, COUNT(*) AS cnt, %v AS amount FROM %v WHERE %v GROUP BY k ORDER BY cnt DESC, k ASC",
+			column, amount, table, cond)
+		queryArgs = args
+	}
+	if options != nil {
+		query += " LIMIT ? OFFSET ?"
+		queryArgs = append(queryArgs, options.Limit, options.Offset)
+	}
+	if column != "" {
+		query = "SELECT s.k, r.data, s.cnt, s.amount FROM (" + query + ") s LEFT JOIN ref r ON s.k = r.id ORDER BY s.cnt DESC, s.k ASC"
+	}
+
+	rows, err := db.db.QueryContext(ctx, db.dialect.rebind(query), queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var all []*Stats
+	for rows.Next() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		var (
+			key     uint64
+			address []byte
+			count   uint64
+			sum     []byte
+		)
+		if err := rows.Scan(&key, &address, &count, &sum); err != nil {
+			return nil, err
+		}
+		stats := &Stats{Count: count}
+		if column == "" {
+			from := key * uint64(bucketSize)
+			to := from + uint64(bucketSize) - 1
+			if to > math.MaxUint32 {
+				to = math.MaxUint32
+			}
+			stats.Range = &Range{
+				From: uint32(from),
+				To:   uint32(to),
+			}
+		} else {
+			addr := thor.BytesToAddress(address)
+			stats.Address = &addr
+		}
+		if table == "transfer" {
+			stats.Amount = db.dialect.parseAmount(sum)
+		}
+		all = append(all, stats)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
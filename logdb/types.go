@@ -7,7 +7,9 @@ package logdb
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 
 	"github.com/vechain/thor/thor"
 )
@@ -26,6 +28,11 @@ type Event struct {
 	Data        []byte
 }
 
+// Cursor returns the position of the event, which can be used to resume filtering after it.
+func (e *Event) Cursor() uint64 {
+	return uint64(newSequence(e.BlockNumber, e.Index))
+}
+
 //Transfer represents tx.Transfer that can be stored in db.
 type Transfer struct {
 	BlockNumber uint32
@@ -40,6 +47,11 @@ type Transfer struct {
 	Amount      *big.Int
 }
 
+// Cursor returns the position of the transfer, which can be used to resume filtering after it.
+func (t *Transfer) Cursor() uint64 {
+	return uint64(newSequence(t.BlockNumber, t.Index))
+}
+
 type Order string
 
 const (
@@ -52,47 +64,146 @@ type Range struct {
 	To   uint32
 }
 
+// TimeRange is the range of block timestamps, both ends inclusive.
+type TimeRange struct {
+	From uint64
+	To   uint64
+}
+
 type Options struct {
 	Offset uint64
 	Limit  uint64
 }
 
 type EventCriteria struct {
-	Address *thor.Address // always a contract address
-	Topics  [5]*thor.Bytes32
+	Addresses []thor.Address    // any of the contract addresses
+	Topics    [5][]thor.Bytes32 // any of the values for each topic
+	TxID      *thor.Bytes32     // the tx which emitted events
+	TxOrigin  *thor.Address     // who send transaction
 }
 
 func (c *EventCriteria) toWhereCondition() (cond string, args []interface{}) {
-	cond = "1"
-	if c.Address != nil {
-		cond += " AND address = " + refIDQuery
-		args = append(args, c.Address.Bytes())
-	}
-	for i, topic := range c.Topics {
-		if topic != nil {
-			cond += fmt.Sprintf(" AND topic%v = ", i) + refIDQuery
-			args = append(args, topic.Bytes())
+	cond = "1=1"
+	if c.TxID != nil {
+		cond += " AND txID = " + refIDQuery
+		args = append(args, c.TxID.Bytes())
+	}
+	if c.TxOrigin != nil {
+		cond += " AND txOrigin = " + refIDQuery
+		args = append(args, c.TxOrigin.Bytes())
+	}
+	if len(c.Addresses) > 0 {
+		data := make([][]byte, 0, len(c.Addresses))
+		for _, addr := range c.Addresses {
+			data = append(data, addr.Bytes())
+		}
+		rcond, rargs := refInCondition("address", data)
+		cond += " AND " + rcond
+		args = append(args, rargs...)
+	}
+	for i, topics := range c.Topics {
+		if len(topics) > 0 {
+			data := make([][]byte, 0, len(topics))
+			for _, topic := range topics {
+				data = append(data, topic.Bytes())
+			}
+			rcond, rargs := refInCondition(fmt.Sprintf("topic%v", i), data)
+			cond += " AND " + rcond
+			args = append(args, rargs...)
 		}
 	}
 	return
 }
 
+// refInCondition builds the condition that the ref column refers to any of the data.
+func refInCondition(column string, data [][]byte) (cond string, args []interface{}) {
+	if len(data) == 1 {
+		return column + " = " + refIDQuery, []interface{}{data[0]}
+	}
+	cond = column + " IN (SELECT id FROM ref WHERE data IN (?" + strings.Repeat(",?", len(data)-1) + "))"
+	for _, d := range data {
+		args = append(args, d)
+	}
+	return
+}
+
+// Match returns whether the event matches the criteria, the same as the where condition does.
+func (c *EventCriteria) Match(ev *Event) bool {
+	if c.TxID != nil && *c.TxID != ev.TxID {
+		return false
+	}
+	if c.TxOrigin != nil && *c.TxOrigin != ev.TxOrigin {
+		return false
+	}
+	if len(c.Addresses) > 0 && !containsAddress(c.Addresses, ev.Address) {
+		return false
+	}
+	for i, topics := range c.Topics {
+		if len(topics) > 0 && (ev.Topics[i] == nil || !containsBytes32(topics, *ev.Topics[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addrs []thor.Address, addr thor.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBytes32(values []thor.Bytes32, v thor.Bytes32) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
 //EventFilter filter
 type EventFilter struct {
 	CriteriaSet []*EventCriteria
 	Range       *Range
+	TimeRange   *TimeRange
+	Cursor      *uint64 // cursor of the last returned row, to resume after it
 	Options     *Options
 	Order       Order //default asc
 }
 
+func (f *EventFilter) toWhereCondition() (cond string, args []interface{}) {
+	cond, args = seqCondition("event", f.Range, f.TimeRange, f.Cursor, f.Order)
+	if len(f.CriteriaSet) > 0 {
+		cond += " AND ("
+		for i, c := range f.CriteriaSet {
+			ccond, cargs := c.toWhereCondition()
+			if i > 0 {
+				cond += " OR"
+			}
+			cond += " (" + ccond + ")"
+			args = append(args, cargs...)
+		}
+		cond += ")"
+	}
+	return
+}
+
 type TransferCriteria struct {
+	TxID      *thor.Bytes32 //the tx which made transfers
 	TxOrigin  *thor.Address //who send transaction
 	Sender    *thor.Address //who transferred tokens
 	Recipient *thor.Address //who recieved tokens
 }
 
 func (c *TransferCriteria) toWhereCondition() (cond string, args []interface{}) {
-	cond = "1"
+	cond = "1=1"
+	if c.TxID != nil {
+		cond += " AND txID = " + refIDQuery
+		args = append(args, c.TxID.Bytes())
+	}
 	if c.TxOrigin != nil {
 		cond += " AND txOrigin = " + refIDQuery
 		args = append(args, c.TxOrigin.Bytes())
@@ -111,6 +222,122 @@ func (c *TransferCriteria) toWhereCondition() (cond string, args []interface{})
 type TransferFilter struct {
 	CriteriaSet []*TransferCriteria
 	Range       *Range
+	TimeRange   *TimeRange
+	Cursor      *uint64 // cursor of the last returned row, to resume after it
+	Options     *Options
+	Order       Order //default asc
+}
+
+func (f *TransferFilter) toWhereCondition() (cond string, args []interface{}) {
+	cond, args = seqCondition("transfer", f.Range, f.TimeRange, f.Cursor, f.Order)
+	if len(f.CriteriaSet) > 0 {
+		cond += " AND ("
+		for i, c := range f.CriteriaSet {
+			ccond, cargs := c.toWhereCondition()
+			if i > 0 {
+				cond += " OR"
+			}
+			cond += " (" + ccond + ")"
+			args = append(args, cargs...)
+		}
+		cond += ")"
+	}
+	return
+}
+
+// Reverted represents the attempt of a clause of the reverted tx, which is recorded if opted in.
+type Reverted struct {
+	BlockNumber uint32
+	Index       uint32
+	BlockID     thor.Bytes32
+	BlockTime   uint64
+	TxID        thor.Bytes32
+	TxOrigin    thor.Address
+	ClauseIndex uint32
+	Target      *thor.Address // nil for contract creation
+	Amount      *big.Int      // VET value of the clause
+}
+
+// Cursor returns the position of the reverted attempt, which can be used to resume filtering after it.
+func (r *Reverted) Cursor() uint64 {
+	return uint64(newSequence(r.BlockNumber, r.Index))
+}
+
+type RevertedCriteria struct {
+	TxID     *thor.Bytes32 //the reverted tx
+	TxOrigin *thor.Address //who send transaction
+	Target   *thor.Address //the clause target
+}
+
+func (c *RevertedCriteria) toWhereCondition() (cond string, args []interface{}) {
+	cond = "1=1"
+	if c.TxID != nil {
+		cond += " AND txID = " + refIDQuery
+		args = append(args, c.TxID.Bytes())
+	}
+	if c.TxOrigin != nil {
+		cond += " AND txOrigin = " + refIDQuery
+		args = append(args, c.TxOrigin.Bytes())
+	}
+	if c.Target != nil {
+		cond += " AND target = " + refIDQuery
+		args = append(args, c.Target.Bytes())
+	}
+	return
+}
+
+type RevertedFilter struct {
+	CriteriaSet []*RevertedCriteria
+	Range       *Range
+	TimeRange   *TimeRange
+	Cursor      *uint64 // cursor of the last returned row, to resume after it
 	Options     *Options
 	Order       Order //default asc
 }
+
+func (f *RevertedFilter) toWhereCondition() (cond string, args []interface{}) {
+	cond, args = seqCondition("reverted", f.Range, f.TimeRange, f.Cursor, f.Order)
+	if len(f.CriteriaSet) > 0 {
+		cond += " AND ("
+		for i, c := range f.CriteriaSet {
+			ccond, cargs := c.toWhereCondition()
+			if i > 0 {
+				cond += " OR"
+			}
+			cond += " (" + ccond + ")"
+			args = append(args, cargs...)
+		}
+		cond += ")"
+	}
+	return
+}
+
+// seqCondition builds the where condition to limit seq of rows in the given table.
+func seqCondition(table string, rng *Range, timeRange *TimeRange, cursor *uint64, order Order) (cond string, args []interface{}) {
+	cond = "1=1"
+	if rng != nil {
+		cond += " AND seq >= ?"
+		args = append(args, newSequence(rng.From, 0))
+		if rng.To >= rng.From {
+			cond += " AND seq <= ?"
+			args = append(args, newSequence(rng.To, uint32(math.MaxInt32)))
+		}
+	}
+
+	if timeRange != nil {
+		// block time grows along with seq, so the time range is turned into a seq range via the blockTime index
+		cond += fmt.Sprintf(" AND seq >= (SELECT seq FROM %v WHERE blockTime >= ? ORDER BY blockTime ASC, seq ASC LIMIT 1)", table)
+		cond += fmt.Sprintf(" AND seq <= (SELECT seq FROM %v WHERE blockTime <= ? ORDER BY blockTime DESC, seq DESC LIMIT 1)", table)
+		args = append(args, timeRange.From, timeRange.To)
+	}
+
+	if cursor != nil {
+		if order == DESC {
+			cond += " AND seq < ?"
+		} else {
+			cond += " AND seq > ?"
+		}
+		args = append(args, *cursor)
+	}
+	return
+}
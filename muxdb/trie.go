@@ -162,6 +162,16 @@ func (t *Trie) NodeIterator(start []byte) trie.NodeIterator {
 	return obj.NodeIterator(start)
 }
 
+// Prove constructs a merkle proof for key. All encoded nodes on the path to
+// the value at key are written into proofDb. See trie.Trie.Prove for details.
+func (t *Trie) Prove(key []byte, fromLevel uint, proofDb trie.DatabaseWriter) error {
+	obj, err := t.lazyInit()
+	if err != nil {
+		return err
+	}
+	return obj.Prove(t.hashKey(key, false), fromLevel, proofDb)
+}
+
 // GetKeyPreimage returns the blake2b preimage of a hashed key that was
 // previously used to store a value.
 func (t *Trie) GetKeyPreimage(hash thor.Bytes32) []byte {
@@ -32,17 +32,30 @@ func ResolveTransaction(tx *tx.Transaction) (*ResolvedTransaction, error) {
 	if err != nil {
 		return nil, err
 	}
-	intrinsicGas, err := tx.IntrinsicGas()
+	delegator, err := tx.Delegator()
 	if err != nil {
 		return nil, err
 	}
-	if tx.Gas() < intrinsicGas {
-		return nil, errors.New("intrinsic gas exceeds provided gas")
+	return resolve(tx, origin, delegator)
+}
+
+// ResolveUnsignedTransaction resolves the transaction with the given origin and delegator,
+// rather than recovering them from the signature. It's for simulation purpose only.
+func ResolveUnsignedTransaction(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) (*ResolvedTransaction, error) {
+	if tx.Features().IsDelegated() != (delegator != nil) {
+		return nil, errors.New("delegator and delegation feature mismatch")
 	}
-	delegator, err := tx.Delegator()
+	return resolve(tx, origin, delegator)
+}
+
+func resolve(tx *tx.Transaction, origin thor.Address, delegator *thor.Address) (*ResolvedTransaction, error) {
+	intrinsicGas, err := tx.IntrinsicGas()
 	if err != nil {
 		return nil, err
 	}
+	if tx.Gas() < intrinsicGas {
+		return nil, errors.New("intrinsic gas exceeds provided gas")
+	}
 
 	clauses := tx.Clauses()
 	sumValue := new(big.Int)
@@ -202,8 +215,13 @@ func (r *ResolvedTransaction) ToContext(
 	if err != nil {
 		return nil, err
 	}
+	id := r.tx.ID()
+	if id.IsZero() {
+		// unsigned tx, derive id from the given origin the same way as signed one
+		id = r.tx.DelegatorSigningHash(r.Origin)
+	}
 	return &xenv.TransactionContext{
-		ID:         r.tx.ID(),
+		ID:         id,
 		Origin:     r.Origin,
 		GasPayer:   gasPayer,
 		GasPrice:   gasPrice,
@@ -96,6 +96,32 @@ func (tr *testResolvedTransaction) TestResolveTransaction() {
 	tr.assert.Nil(err)
 }
 
+func (tr *testResolvedTransaction) TestResolveUnsignedTransaction() {
+	origin := thor.BytesToAddress([]byte("origin"))
+	delegator := thor.BytesToAddress([]byte("delegator"))
+
+	unsigned := txBuilder(tr.repo.ChainTag()).Build()
+	resolved, err := runtime.ResolveUnsignedTransaction(unsigned, origin, nil)
+	tr.assert.Nil(err)
+	tr.assert.Equal(origin, resolved.Origin)
+	tr.assert.Nil(resolved.Delegator)
+
+	_, err = runtime.ResolveUnsignedTransaction(unsigned, origin, &delegator)
+	tr.assert.NotNil(err, "delegation feature not set")
+
+	delegated := txBuilder(tr.repo.ChainTag()).Features(tx.DelegationFeature).Build()
+	_, err = runtime.ResolveUnsignedTransaction(delegated, origin, nil)
+	tr.assert.NotNil(err, "delegator required")
+
+	resolved, err = runtime.ResolveUnsignedTransaction(delegated, origin, &delegator)
+	tr.assert.Nil(err)
+	tr.assert.Equal(delegator, *resolved.Delegator)
+
+	txCtx, err := resolved.ToContext(big.NewInt(1), delegator, 0, tr.repo.NewBestChain().GetBlockID)
+	tr.assert.Nil(err)
+	tr.assert.Equal(delegated.DelegatorSigningHash(origin), txCtx.ID)
+}
+
 func (tr *testResolvedTransaction) TestCommonTo() {
 
 	txBuild := func() *tx.Builder {
@@ -348,6 +348,12 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 	if err != nil {
 		return nil, err
 	}
+	return rt.PrepareResolvedTransaction(resolvedTx)
+}
+
+// PrepareResolvedTransaction prepare to execute the resolved tx.
+func (rt *Runtime) PrepareResolvedTransaction(resolvedTx *ResolvedTransaction) (*TransactionExecutor, error) {
+	tx := resolvedTx.tx
 
 	baseGasPrice, gasPrice, payer, returnGas, err := resolvedTx.BuyGas(rt.state, rt.ctx.Time)
 	if err != nil {
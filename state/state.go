@@ -323,6 +323,40 @@ func (s *State) Delete(addr thor.Address) {
 	s.updateAccount(addr, emptyAccount())
 }
 
+// ProveAccount returns the merkle proof of the account at the given address,
+// against the state root. The proof is a list of RLP encoded trie nodes from the root node.
+// Uncommitted changes are not reflected.
+func (s *State) ProveAccount(addr thor.Address) ([][]byte, error) {
+	var proof proofList
+	if err := s.trie.Prove(addr[:], 0, &proof); err != nil {
+		return nil, &Error{err}
+	}
+	return proof, nil
+}
+
+// ProveStorage returns the merkle proof of the storage value for the given key,
+// against the storage root of the account.
+// Uncommitted changes are not reflected.
+func (s *State) ProveStorage(addr thor.Address, key thor.Bytes32) ([][]byte, error) {
+	co, err := s.getCachedObject(addr)
+	if err != nil {
+		return nil, &Error{err}
+	}
+	var proof proofList
+	if err := co.getOrCreateStorageTrie().Prove(key[:], 0, &proof); err != nil {
+		return nil, &Error{err}
+	}
+	return proof, nil
+}
+
+// proofList collects proof nodes in order.
+type proofList [][]byte
+
+func (l *proofList) Put(key, value []byte) error {
+	*l = append(*l, append([]byte(nil), value...))
+	return nil
+}
+
 // NewCheckpoint makes a checkpoint of current state.
 // It returns revision of the checkpoint.
 func (s *State) NewCheckpoint() int {
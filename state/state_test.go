@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/muxdb"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 )
 
 func TestStateReadWrite(t *testing.T) {
@@ -146,3 +147,62 @@ func TestStorage(t *testing.T) {
 
 	assert.Equal(t, M(thor.Blake2b(data), nil), M(st.GetStorage(addr, key)))
 }
+
+type proofReader map[thor.Bytes32][]byte
+
+func (r proofReader) Get(key []byte) ([]byte, error) { return r[thor.BytesToBytes32(key)], nil }
+func (r proofReader) Has(key []byte) (bool, error) {
+	_, ok := r[thor.BytesToBytes32(key)]
+	return ok, nil
+}
+
+func newProofReader(proof [][]byte) proofReader {
+	r := make(proofReader)
+	for _, node := range proof {
+		r[thor.Blake2b(node)] = node
+	}
+	return r
+}
+
+func TestProve(t *testing.T) {
+	db := muxdb.NewMem()
+	st := New(db, thor.Bytes32{})
+
+	addr := thor.BytesToAddress([]byte("addr"))
+	key := thor.BytesToBytes32([]byte("key"))
+
+	st.SetBalance(addr, big.NewInt(100))
+	st.SetStorage(addr, key, thor.BytesToBytes32([]byte{1}))
+	stage, err := st.Stage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := stage.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st = New(db, root)
+	proof, err := st.ProveAccount(addr)
+	assert.Nil(t, err)
+	enc, err, _ := trie.VerifyProof(root, thor.Blake2b(addr[:]).Bytes(), newProofReader(proof))
+	assert.Nil(t, err)
+	var acc Account
+	assert.Nil(t, rlp.DecodeBytes(enc, &acc))
+	assert.Equal(t, big.NewInt(100), acc.Balance)
+
+	proof, err = st.ProveStorage(addr, key)
+	assert.Nil(t, err)
+	enc, err, _ = trie.VerifyProof(thor.BytesToBytes32(acc.StorageRoot), thor.Blake2b(key[:]).Bytes(), newProofReader(proof))
+	assert.Nil(t, err)
+	data, _ := rlp.EncodeToBytes([]byte{1})
+	assert.Equal(t, data, enc)
+
+	// proof of absence
+	absent := thor.BytesToAddress([]byte("absent"))
+	proof, err = st.ProveAccount(absent)
+	assert.Nil(t, err)
+	enc, err, _ = trie.VerifyProof(root, thor.Blake2b(absent[:]).Bytes(), newProofReader(proof))
+	assert.Nil(t, err)
+	assert.Nil(t, enc)
+}
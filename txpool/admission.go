@@ -0,0 +1,143 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// AdmissionPolicy decides whether a tx is admitted into the pool.
+// It's consulted for each new tx after the tx is resolved, so the policy can be stateful, e.g. to limit rates.
+type AdmissionPolicy interface {
+	// Admit returns an error describing why the tx is not admitted, or nil if admitted.
+	// An admitted tx is counted by the policy, e.g. against rate limits, at once.
+	Admit(tx *tx.Transaction, origin thor.Address) error
+	// Rejected is called if the admitted tx is then not added into the pool for other reasons,
+	// e.g. the pool is full, to give back what it's counted against.
+	Rejected(tx *tx.Transaction, origin thor.Address)
+}
+
+// AdmissionRules is the built-in admission policy. Zero valued rules are not applied.
+type AdmissionRules struct {
+	AllowedTargets  []thor.Address `json:"allowedTargets"`  // clause targets allowed, contract creation disallowed if set
+	MinGasPriceCoef uint8          `json:"minGasPriceCoef"` // min gas price coef required
+	MaxClauses      int            `json:"maxClauses"`      // max count of clauses
+	DelegatedOnly   bool           `json:"delegatedOnly"`   // whether only delegated txs allowed
+	RateLimit       *RateLimit     `json:"rateLimit"`       // per-origin rate limit
+}
+
+// RateLimit limits the count of txs admitted from each origin in each period.
+type RateLimit struct {
+	Txs    int    `json:"txs"`
+	Period uint64 `json:"period"` // in seconds
+}
+
+// LoadAdmissionRules loads admission rules in JSON from the file, and creates the admission policy.
+func LoadAdmissionRules(path string) (AdmissionPolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules AdmissionRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return NewAdmissionPolicy(&rules)
+}
+
+// NewAdmissionPolicy creates the admission policy applying the rules.
+func NewAdmissionPolicy(rules *AdmissionRules) (AdmissionPolicy, error) {
+	if rules.MaxClauses < 0 {
+		return nil, errors.New("negative maxClauses")
+	}
+	if rl := rules.RateLimit; rl != nil && (rl.Txs <= 0 || rl.Period == 0) {
+		return nil, errors.New("rateLimit: txs and period should be positive")
+	}
+
+	p := &rulesPolicy{
+		rules: *rules,
+		now:   time.Now,
+	}
+	if len(rules.AllowedTargets) > 0 {
+		p.allowedTargets = make(map[thor.Address]bool, len(rules.AllowedTargets))
+		for _, addr := range rules.AllowedTargets {
+			p.allowedTargets[addr] = true
+		}
+	}
+	return p, nil
+}
+
+// rulesPolicy implements AdmissionPolicy with AdmissionRules.
+type rulesPolicy struct {
+	rules          AdmissionRules
+	allowedTargets map[thor.Address]bool
+	now            func() time.Time
+
+	lock        sync.Mutex
+	windowStart time.Time
+	counts      map[thor.Address]int // txs added from each origin in the current window
+}
+
+func (p *rulesPolicy) Admit(trx *tx.Transaction, origin thor.Address) error {
+	if trx.GasPriceCoef() < p.rules.MinGasPriceCoef {
+		return fmt.Errorf("gas price coef lower than %v", p.rules.MinGasPriceCoef)
+	}
+	if p.rules.MaxClauses > 0 && len(trx.Clauses()) > p.rules.MaxClauses {
+		return fmt.Errorf("more than %v clauses", p.rules.MaxClauses)
+	}
+	if p.rules.DelegatedOnly && !trx.Features().IsDelegated() {
+		return errors.New("not delegated")
+	}
+	if p.allowedTargets != nil {
+		for _, c := range trx.Clauses() {
+			if to := c.To(); to == nil || !p.allowedTargets[*to] {
+				return errors.New("clause target not allowed")
+			}
+		}
+	}
+	if rl := p.rules.RateLimit; rl != nil {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		counts := p.window()
+		if counts[origin] >= rl.Txs {
+			return fmt.Errorf("rate limit exceeded, %v txs per %v seconds", rl.Txs, rl.Period)
+		}
+		counts[origin]++
+	}
+	return nil
+}
+
+// Rejected gives back the count of the tx from the origin, against the rate limit.
+func (p *rulesPolicy) Rejected(trx *tx.Transaction, origin thor.Address) {
+	if p.rules.RateLimit != nil {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		// the window may be renewed since admitted
+		if counts := p.window(); counts[origin] > 0 {
+			counts[origin]--
+		}
+	}
+}
+
+// window returns counts of txs added from each origin, in fixed windows of the period.
+func (p *rulesPolicy) window() map[thor.Address]int {
+	rl := p.rules.RateLimit
+	if now := p.now(); p.counts == nil || now.Sub(p.windowStart) >= time.Duration(rl.Period)*time.Second {
+		p.windowStart = now
+		p.counts = make(map[thor.Address]int)
+	}
+	return p.counts
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestAdmissionRules(t *testing.T) {
+	var (
+		acc     = genesis.DevAccounts()[0]
+		allowed = thor.BytesToAddress([]byte("allowed"))
+		other   = thor.BytesToAddress([]byte("other"))
+	)
+	newTx := func(coef uint8, features tx.Features, targets ...*thor.Address) *tx.Transaction {
+		builder := new(tx.Builder).GasPriceCoef(coef).Features(features).Gas(21000)
+		for _, to := range targets {
+			builder.Clause(tx.NewClause(to))
+		}
+		return builder.Build()
+	}
+
+	tests := []struct {
+		rules  AdmissionRules
+		tx     *tx.Transaction
+		errStr string
+	}{
+		{AdmissionRules{}, newTx(0, 0, nil, &other), ""},
+		{AdmissionRules{MinGasPriceCoef: 10}, newTx(9, 0, &other), "gas price coef lower than 10"},
+		{AdmissionRules{MinGasPriceCoef: 10}, newTx(10, 0, &other), ""},
+		{AdmissionRules{MaxClauses: 1}, newTx(0, 0, &other, &other), "more than 1 clauses"},
+		{AdmissionRules{MaxClauses: 1}, newTx(0, 0, &other), ""},
+		{AdmissionRules{DelegatedOnly: true}, newTx(0, 0, &other), "not delegated"},
+		{AdmissionRules{DelegatedOnly: true}, newTx(0, tx.DelegationFeature, &other), ""},
+		{AdmissionRules{AllowedTargets: []thor.Address{allowed}}, newTx(0, 0, &allowed, &other), "clause target not allowed"},
+		{AdmissionRules{AllowedTargets: []thor.Address{allowed}}, newTx(0, 0, nil), "clause target not allowed"},
+		{AdmissionRules{AllowedTargets: []thor.Address{allowed}}, newTx(0, 0, &allowed, &allowed), ""},
+	}
+
+	for _, tt := range tests {
+		policy, err := NewAdmissionPolicy(&tt.rules)
+		assert.Nil(t, err)
+		err = policy.Admit(tt.tx, acc.Address)
+		if tt.errStr == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, tt.errStr)
+		}
+	}
+
+	_, err := NewAdmissionPolicy(&AdmissionRules{RateLimit: &RateLimit{Txs: 1}})
+	assert.NotNil(t, err)
+}
+
+func TestAdmissionRateLimit(t *testing.T) {
+	policy, err := NewAdmissionPolicy(&AdmissionRules{RateLimit: &RateLimit{Txs: 2, Period: 10}})
+	assert.Nil(t, err)
+
+	now := time.Unix(1000, 0)
+	policy.(*rulesPolicy).now = func() time.Time { return now }
+
+	var (
+		trx = new(tx.Builder).Build()
+		a1  = genesis.DevAccounts()[0].Address
+		a2  = genesis.DevAccounts()[1].Address
+	)
+	assert.Nil(t, policy.Admit(trx, a1))
+	assert.Nil(t, policy.Admit(trx, a1))
+	assert.EqualError(t, policy.Admit(trx, a1), "rate limit exceeded, 2 txs per 10 seconds")
+	assert.Nil(t, policy.Admit(trx, a2))
+
+	// given back if rejected after admitted
+	policy.Rejected(trx, a1)
+	assert.Nil(t, policy.Admit(trx, a1))
+	assert.NotNil(t, policy.Admit(trx, a1))
+
+	now = now.Add(10 * time.Second)
+	assert.Nil(t, policy.Admit(trx, a1))
+
+	// not given back more than counted in the renewed window
+	policy.Rejected(trx, a2)
+	assert.Nil(t, policy.Admit(trx, a2))
+	assert.Nil(t, policy.Admit(trx, a2))
+	assert.NotNil(t, policy.Admit(trx, a2))
+}
+
+func TestAdmissionRateLimitConcurrent(t *testing.T) {
+	const (
+		n     = 100
+		limit = 5
+	)
+	policy, err := NewAdmissionPolicy(&AdmissionRules{RateLimit: &RateLimit{Txs: limit, Period: 3600}})
+	assert.Nil(t, err)
+
+	var (
+		trx      = new(tx.Builder).Build()
+		origin   = genesis.DevAccounts()[0].Address
+		admitted uint32
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if policy.Admit(trx, origin) == nil {
+				atomic.AddUint32(&admitted, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint32(limit), admitted)
+}
+
+func TestAdmissionRateLimitInPool(t *testing.T) {
+	policy, err := NewAdmissionPolicy(&AdmissionRules{RateLimit: &RateLimit{Txs: 2, Period: 3600}})
+	assert.Nil(t, err)
+
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	pool := New(repo, state.NewStater(db), Options{
+		Limit:           10,
+		LimitPerAccount: 1,
+		MaxLifetime:     time.Hour,
+		AdmissionPolicy: policy,
+	})
+	defer pool.Close()
+
+	newTx := func(acc genesis.DevAccount, nonce uint64) *tx.Transaction {
+		return signTx(new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Expiration(100).
+			Nonce(nonce).
+			Gas(21000).
+			Build(), acc)
+	}
+	a0, a1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+
+	// filled txs are subject to the policy too
+	pool.Fill(tx.Transactions{newTx(a0, 1), newTx(a0, 2), newTx(a0, 3)})
+	assert.Equal(t, 2, len(pool.Dump()))
+	err = pool.Add(newTx(a0, 4))
+	assert.True(t, IsTxRejected(err))
+	assert.EqualError(t, err, "tx rejected: not admitted: rate limit exceeded, 2 txs per 3600 seconds")
+
+	// filled txs with nonces already pooled are not counted
+	a2 := genesis.DevAccounts()[2]
+	sameNonce := signTx(new(tx.Builder).
+		ChainTag(repo.ChainTag()).
+		Expiration(100).
+		Nonce(1).
+		GasPriceCoef(1).
+		Gas(21000).
+		Build(), a2)
+	pool.Fill(tx.Transactions{newTx(a2, 1), sameNonce})
+	assert.Equal(t, 3, len(pool.Dump()))
+	pool.Fill(tx.Transactions{newTx(a2, 2)})
+	assert.Equal(t, 4, len(pool.Dump()))
+	assert.True(t, IsTxRejected(pool.Add(newTx(a2, 3))))
+
+	// rejected by the account quota after admitted, not counted
+	trx := newTx(a1, 1)
+	assert.Nil(t, pool.Add(trx))
+	assert.True(t, IsTxRejected(pool.Add(newTx(a1, 2))))
+	assert.True(t, pool.Remove(trx.Hash(), trx.ID()))
+	assert.Nil(t, pool.Add(newTx(a1, 3)))
+}
+
+func TestLoadAdmissionRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-admission")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rules.json")
+	if err := ioutil.WriteFile(path, []byte(`{"minGasPriceCoef": 128, "maxClauses": 2}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadAdmissionRules(path)
+	assert.Nil(t, err)
+
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	pool := New(repo, state.NewStater(db), Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     time.Hour,
+		AdmissionPolicy: policy,
+	})
+	defer pool.Close()
+
+	trx := newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	err = pool.Add(trx)
+	assert.True(t, IsTxRejected(err))
+	assert.EqualError(t, err, "tx rejected: not admitted: gas price coef lower than 128")
+}
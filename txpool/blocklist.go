@@ -7,25 +7,79 @@ package txpool
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/vechain/thor/thor"
 )
 
+const (
+	// max size of a fetched list or signature
+	maxBlocklistSize = 16 * 1024 * 1024
+	// suffix of the url of the detached signature of a list
+	blocklistSigSuffix = ".sig"
+)
+
 // blocklist is a address list contains addresses that are blocked.
+// It merges lists fetched from multiple sources, and addresses in the allowlist are never blocked by them.
 type blocklist struct {
-	list map[thor.Address]bool
-	lock sync.Mutex
+	sources   []*blocklistSource
+	allowlist map[thor.Address]bool
+	signers   map[thor.Address]bool // to verify fetched lists, not verified if empty
+
+	lock   sync.Mutex
+	list   map[thor.Address]bool // merged list
+	cached map[thor.Address]bool // loaded from local file saved by older versions, standing for sources not cached
+}
+
+// blocklistSource is a remote list.
+type blocklistSource struct {
+	url         string
+	eTag        string
+	list        map[thor.Address]bool // nil if not fetched yet
+	cached      map[thor.Address]bool // loaded from local file, standing for the list not fetched yet
+	lastUpdated time.Time
+	lastErr     error
+}
+
+// cacheKey returns the key of the source in local file, which is the hash of the url, not to save credentials in it.
+func (src *blocklistSource) cacheKey() string {
+	return thor.Blake2b([]byte(src.url)).String()
+}
+
+func newBlocklist(urls []string, allowlist []thor.Address, publicKeys []*ecdsa.PublicKey) *blocklist {
+	bl := &blocklist{
+		allowlist: make(map[thor.Address]bool, len(allowlist)),
+		signers:   make(map[thor.Address]bool, len(publicKeys)),
+	}
+	for _, u := range urls {
+		bl.sources = append(bl.sources, &blocklistSource{url: u})
+	}
+	for _, addr := range allowlist {
+		bl.allowlist[addr] = true
+	}
+	for _, key := range publicKeys {
+		bl.signers[thor.Address(crypto.PubkeyToAddress(*key))] = true
+	}
+	return bl
 }
 
-// Load load list from local file.
+// Load loads lists of sources from local file.
+// The list of each source is in a section headed by the line '# <cache key>', and addresses out of any section,
+// which are merged lists saved by older versions, stand for sources not cached.
 func (bl *blocklist) Load(path string) error {
 	file, err := os.Open(path)
 	if err != nil {
@@ -33,19 +87,23 @@ func (bl *blocklist) Load(path string) error {
 	}
 	defer file.Close()
 
-	newList, err := bl.readList(file)
+	sections, err := bl.readSections(file)
 	if err != nil {
 		return err
 	}
 
 	bl.lock.Lock()
-	bl.list = newList
+	bl.cached = sections[""]
+	for _, src := range bl.sources {
+		src.cached = sections[src.cacheKey()]
+	}
+	bl.merge()
 	bl.lock.Unlock()
 
 	return nil
 }
 
-// Save save list to local file.
+// Save saves lists of sources to local file, before the allowlist applied.
 func (bl *blocklist) Save(path string) error {
 	file, err := os.Create(path)
 	if err != nil {
@@ -53,61 +111,172 @@ func (bl *blocklist) Save(path string) error {
 	}
 	defer file.Close()
 
-	var listToSave []thor.Address
+	var buf bytes.Buffer
+	write := func(list map[thor.Address]bool) {
+		for addr := range list {
+			buf.WriteString(addr.String() + "\n")
+		}
+	}
 
 	bl.lock.Lock()
-	for addr := range bl.list {
-		listToSave = append(listToSave, addr)
+	for _, src := range bl.sources {
+		if src.list == nil && src.cached == nil {
+			// still stood for by the old cached list
+			write(bl.cached)
+			break
+		}
+	}
+	for _, src := range bl.sources {
+		list := src.list
+		if list == nil {
+			list = src.cached
+		}
+		if list != nil {
+			buf.WriteString("# " + src.cacheKey() + "\n")
+			write(list)
+		}
 	}
 	bl.lock.Unlock()
 
-	for _, addr := range listToSave {
-		if _, err := file.WriteString(addr.String() + "\n"); err != nil {
-			return err
+	_, err = file.Write(buf.Bytes())
+	return err
+}
+
+// URLs returns urls of sources.
+func (bl *blocklist) URLs() []string {
+	urls := make([]string, 0, len(bl.sources))
+	for _, src := range bl.sources {
+		urls = append(urls, src.url)
+	}
+	return urls
+}
+
+// Fetch fetch list from the source of the given url. The list is verified if signers configured.
+// It returns whether the list is updated.
+func (bl *blocklist) Fetch(ctx context.Context, url string) (bool, error) {
+	var src *blocklistSource
+	for _, s := range bl.sources {
+		if s.url == url {
+			src = s
+			break
 		}
 	}
-	return nil
+	if src == nil {
+		return false, fmt.Errorf("unknown source %v", url)
+	}
+
+	bl.lock.Lock()
+	eTag := src.eTag
+	bl.lock.Unlock()
+
+	data, newETag, err := bl.get(ctx, url, eTag)
+	if err == nil && data != nil {
+		if len(bl.signers) > 0 {
+			err = bl.verify(ctx, url, data)
+		}
+	}
+	var newList map[thor.Address]bool
+	if err == nil && data != nil {
+		newList, err = bl.readList(bytes.NewReader(data))
+	}
+
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	src.lastErr = err
+	if err != nil || data == nil {
+		return false, err
+	}
+	src.eTag = newETag
+	src.list = newList
+	src.lastUpdated = time.Now()
+	bl.merge()
+	return true, nil
 }
 
-// Fetch fetch list from remote url.
-func (bl *blocklist) Fetch(ctx context.Context, url string, eTag *string) error {
+// get gets the content of the url. Nil data returned if not modified.
+func (bl *blocklist) get(ctx context.Context, url, eTag string) (data []byte, newETag string, err error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	if eTag != nil && *eTag != "" {
-		req.Header.Add("if-none-match", *eTag)
+	if eTag != "" {
+		req.Header.Add("if-none-match", eTag)
 	}
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 	defer io.Copy(ioutil.Discard, resp.Body)
 
 	if resp.StatusCode == http.StatusNotModified {
-		return nil
+		return nil, eTag, nil
 	}
 
 	if resp.StatusCode/100 != 2 {
-		return fmt.Errorf("status %v", resp.Status)
+		return nil, "", fmt.Errorf("status %v", resp.Status)
 	}
 
-	newList, err := bl.readList(resp.Body)
+	data, err = ioutil.ReadAll(io.LimitReader(resp.Body, maxBlocklistSize+1))
 	if err != nil {
-		return err
+		return nil, "", err
 	}
+	if len(data) > maxBlocklistSize {
+		return nil, "", errors.New("list too large")
+	}
+	return data, resp.Header.Get("etag"), nil
+}
 
-	bl.lock.Lock()
-	bl.list = newList
-	bl.lock.Unlock()
-
-	if eTag != nil {
-		*eTag = resp.Header.Get("etag")
+// verify verifies the list against its detached signature, which is fetched from the url with suffix '.sig'.
+// The signature is the hex encoded 65 bytes secp256k1 signature of the blake2b hash of the list.
+func (bl *blocklist) verify(ctx context.Context, url string, list []byte) error {
+	sigData, _, err := bl.get(ctx, url+blocklistSigSuffix, "")
+	if err != nil {
+		return fmt.Errorf("get signature: %v", err)
+	}
+	sig, err := hexutil.Decode(strings.TrimSpace(string(sigData)))
+	if err != nil {
+		return fmt.Errorf("decode signature: %v", err)
+	}
+	pub, err := crypto.SigToPub(thor.Blake2b(list).Bytes(), sig)
+	if err != nil {
+		return fmt.Errorf("recover signer: %v", err)
+	}
+	if !bl.signers[thor.Address(crypto.PubkeyToAddress(*pub))] {
+		return errors.New("signature not trusted")
 	}
 	return nil
 }
 
+// merge merges lists of sources. Must be called with the lock held.
+func (bl *blocklist) merge() {
+	merged := make(map[thor.Address]bool)
+	add := func(list map[thor.Address]bool) {
+		for addr := range list {
+			if !bl.allowlist[addr] {
+				merged[addr] = true
+			}
+		}
+	}
+
+	// cached lists stand for sources not fetched yet, and the old cached list for all if no source
+	if len(bl.sources) == 0 {
+		add(bl.cached)
+	}
+	for _, src := range bl.sources {
+		switch {
+		case src.list != nil:
+			add(src.list)
+		case src.cached != nil:
+			add(src.cached)
+		default:
+			add(bl.cached)
+		}
+	}
+	bl.list = merged
+}
+
 // Contains returns whether the given address is listed.
 func (bl *blocklist) Contains(addr thor.Address) bool {
 	bl.lock.Lock()
@@ -123,6 +292,43 @@ func (bl *blocklist) Len() int {
 	return len(bl.list)
 }
 
+// Status returns the status of the blocklist.
+func (bl *blocklist) Status() *BlocklistStatus {
+	bl.lock.Lock()
+	defer bl.lock.Unlock()
+
+	cached := make(map[thor.Address]bool, len(bl.cached))
+	for addr := range bl.cached {
+		cached[addr] = true
+	}
+	for _, src := range bl.sources {
+		for addr := range src.cached {
+			cached[addr] = true
+		}
+	}
+	status := &BlocklistStatus{
+		Entries:   len(bl.list),
+		Cached:    len(cached),
+		Allowlist: len(bl.allowlist),
+		Verified:  len(bl.signers) > 0,
+		Sources:   make([]*BlocklistSourceStatus, 0, len(bl.sources)),
+	}
+	for _, src := range bl.sources {
+		s := &BlocklistSourceStatus{
+			URL:     redactURL(src.url),
+			Entries: len(src.list),
+		}
+		if !src.lastUpdated.IsZero() {
+			s.LastUpdated = src.lastUpdated.Unix()
+		}
+		if src.lastErr != nil {
+			s.LastError = src.lastErr.Error()
+		}
+		status.Sources = append(status.Sources, s)
+	}
+	return status
+}
+
 func (bl *blocklist) readList(r io.Reader) (map[thor.Address]bool, error) {
 	scanner := bufio.NewScanner(r)
 	list := make(map[thor.Address]bool)
@@ -143,3 +349,61 @@ func (bl *blocklist) readList(r io.Reader) (map[thor.Address]bool, error) {
 	}
 	return list, nil
 }
+
+// readSections reads lists in sections headed by lines of '# <key>', the list out of any section is keyed by "".
+func (bl *blocklist) readSections(r io.Reader) (map[string]map[thor.Address]bool, error) {
+	scanner := bufio.NewScanner(r)
+	sections := make(map[string]map[thor.Address]bool)
+
+	var key string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			key = strings.TrimSpace(line[1:])
+			sections[key] = make(map[thor.Address]bool)
+			continue
+		}
+		addr, err := thor.ParseAddress(line)
+		if err != nil {
+			return nil, err
+		}
+		if sections[key] == nil {
+			sections[key] = make(map[thor.Address]bool)
+		}
+		sections[key][addr] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// redactURL removes the user info, which may contain credentials, from the url.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+// BlocklistStatus describes the blocklist of the pool.
+type BlocklistStatus struct {
+	Entries   int                      // count of blocked addresses
+	Cached    int                      // count of addresses loaded from local file, of all sources
+	Allowlist int                      // count of addresses in the allowlist
+	Verified  bool                     // whether fetched lists are verified with signatures
+	Sources   []*BlocklistSourceStatus // remote sources
+}
+
+// BlocklistSourceStatus describes a remote source of the blocklist.
+type BlocklistSourceStatus struct {
+	URL         string
+	Entries     int
+	LastUpdated int64  // unix timestamp of the last update, 0 if never updated
+	LastError   string // error of the last fetch, empty if succeeded
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+// listServer serves lists and their signatures, with the content as etag.
+type listServer map[string]string
+
+func (ls listServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	content, ok := ls[req.URL.Path]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	eTag := `"` + thor.Blake2b([]byte(content)).String() + `"`
+	if req.Header.Get("if-none-match") == eTag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("etag", eTag)
+	w.Write([]byte(content))
+}
+
+func makeList(addrs ...thor.Address) string {
+	var lines []string
+	for _, addr := range addrs {
+		lines = append(lines, addr.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func signList(t *testing.T, list string, key *ecdsa.PrivateKey) string {
+	sig, err := crypto.Sign(thor.Blake2b([]byte(list)).Bytes(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(sig)
+}
+
+func TestBlocklistMerge(t *testing.T) {
+	var (
+		a1 = thor.BytesToAddress([]byte("a1"))
+		a2 = thor.BytesToAddress([]byte("a2"))
+		a3 = thor.BytesToAddress([]byte("a3"))
+	)
+
+	ls := listServer{
+		"/1.txt": makeList(a1, a2),
+		"/2.txt": makeList(a3),
+	}
+	srv := httptest.NewServer(ls)
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "txpool-blocklist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "blocklist")
+	if err := ioutil.WriteFile(path, []byte(makeList(a1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	url1, url2 := srv.URL+"/1.txt", srv.URL+"/2.txt"
+	bl := newBlocklist([]string{url1, url2}, []thor.Address{a2}, nil)
+
+	// the cached list stands for sources not fetched
+	assert.Nil(t, bl.Load(path))
+	assert.True(t, bl.Contains(a1))
+	assert.Equal(t, 1, bl.Len())
+
+	updated, err := bl.Fetch(context.Background(), url2)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.True(t, bl.Contains(a1))
+	assert.True(t, bl.Contains(a3))
+
+	updated, err = bl.Fetch(context.Background(), url1)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.True(t, bl.Contains(a1))
+	assert.False(t, bl.Contains(a2), "allowlisted")
+	assert.True(t, bl.Contains(a3))
+	assert.Equal(t, 2, bl.Len())
+
+	// not modified
+	updated, err = bl.Fetch(context.Background(), url1)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+
+	// failed fetch keeps the list
+	delete(ls, "/2.txt")
+	_, err = bl.Fetch(context.Background(), url2)
+	assert.NotNil(t, err)
+	assert.True(t, bl.Contains(a3))
+
+	status := bl.Status()
+	assert.Equal(t, 2, status.Entries)
+	assert.Equal(t, 1, status.Cached)
+	assert.Equal(t, 1, status.Allowlist)
+	assert.False(t, status.Verified)
+	assert.Equal(t, 2, len(status.Sources))
+	assert.Equal(t, url1, status.Sources[0].URL)
+	assert.Equal(t, 2, status.Sources[0].Entries)
+	assert.NotZero(t, status.Sources[0].LastUpdated)
+	assert.Empty(t, status.Sources[0].LastError)
+	assert.Equal(t, 1, status.Sources[1].Entries)
+	assert.Equal(t, "status 404 Not Found", status.Sources[1].LastError)
+
+	// lists are saved by sources, before the allowlist applied
+	assert.Nil(t, bl.Save(path))
+	cached := newBlocklist([]string{url1, url2}, nil, nil)
+	assert.Nil(t, cached.Load(path))
+	assert.True(t, cached.Contains(a1))
+	assert.True(t, cached.Contains(a2))
+	assert.True(t, cached.Contains(a3))
+	assert.Equal(t, 3, cached.Status().Cached)
+
+	// the address dropped by a source is unblocked, while another source unreachable
+	ls["/1.txt"] = makeList(a2)
+	_, err = cached.Fetch(context.Background(), url1)
+	assert.Nil(t, err)
+	_, err = cached.Fetch(context.Background(), url2)
+	assert.NotNil(t, err)
+	assert.False(t, cached.Contains(a1))
+	assert.True(t, cached.Contains(a3))
+
+	// lists of sources no longer configured are dropped
+	cached = newBlocklist([]string{url2}, nil, nil)
+	assert.Nil(t, cached.Load(path))
+	assert.False(t, cached.Contains(a1))
+	assert.True(t, cached.Contains(a3))
+	assert.Equal(t, 1, cached.Len())
+}
+
+func TestBlocklistOldCache(t *testing.T) {
+	var (
+		a1 = thor.BytesToAddress([]byte("a1"))
+		a2 = thor.BytesToAddress([]byte("a2"))
+	)
+	ls := listServer{"/2.txt": makeList(a2)}
+	srv := httptest.NewServer(ls)
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "txpool-blocklist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "blocklist")
+	// the merged list saved by older versions
+	if err := ioutil.WriteFile(path, []byte(makeList(a1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	url1, url2 := srv.URL+"/1.txt", srv.URL+"/2.txt"
+	bl := newBlocklist([]string{url1, url2}, nil, nil)
+	assert.Nil(t, bl.Load(path))
+	_, err = bl.Fetch(context.Background(), url2)
+	assert.Nil(t, err)
+	assert.True(t, bl.Contains(a1))
+	assert.True(t, bl.Contains(a2))
+
+	// kept for the source not fetched yet
+	assert.Nil(t, bl.Save(path))
+	bl = newBlocklist([]string{url1, url2}, nil, nil)
+	assert.Nil(t, bl.Load(path))
+	assert.True(t, bl.Contains(a1))
+	assert.True(t, bl.Contains(a2))
+	assert.Equal(t, 2, bl.Len())
+
+	// replaced once all sources fetched
+	ls["/1.txt"] = makeList(a2)
+	_, err = bl.Fetch(context.Background(), url1)
+	assert.Nil(t, err)
+	assert.False(t, bl.Contains(a1))
+	assert.True(t, bl.Contains(a2))
+}
+
+func TestBlocklistVerify(t *testing.T) {
+	var (
+		a1 = thor.BytesToAddress([]byte("a1"))
+		a2 = thor.BytesToAddress([]byte("a2"))
+	)
+	signer, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+
+	list1 := makeList(a1)
+	ls := listServer{
+		"/list.txt":     list1,
+		"/list.txt.sig": signList(t, list1, signer),
+	}
+	srv := httptest.NewServer(ls)
+	defer srv.Close()
+
+	url := srv.URL + "/list.txt"
+	bl := newBlocklist([]string{url}, nil, []*ecdsa.PublicKey{&signer.PublicKey})
+
+	updated, err := bl.Fetch(context.Background(), url)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.True(t, bl.Contains(a1))
+	assert.True(t, bl.Status().Verified)
+
+	// signed by untrusted key
+	list2 := makeList(a2)
+	ls["/list.txt"] = list2
+	ls["/list.txt.sig"] = signList(t, list2, other)
+	_, err = bl.Fetch(context.Background(), url)
+	assert.EqualError(t, err, "signature not trusted")
+	assert.True(t, bl.Contains(a1))
+	assert.False(t, bl.Contains(a2))
+
+	// signature of another list
+	ls["/list.txt.sig"] = signList(t, list1, signer)
+	_, err = bl.Fetch(context.Background(), url)
+	assert.EqualError(t, err, "signature not trusted")
+	assert.True(t, bl.Contains(a1))
+
+	// signature missing
+	delete(ls, "/list.txt.sig")
+	_, err = bl.Fetch(context.Background(), url)
+	assert.EqualError(t, err, "get signature: status 404 Not Found")
+	assert.True(t, bl.Contains(a1))
+
+	ls["/list.txt.sig"] = signList(t, list2, signer)
+	updated, err = bl.Fetch(context.Background(), url)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.False(t, bl.Contains(a1))
+	assert.True(t, bl.Contains(a2))
+	assert.Empty(t, bl.Status().Sources[0].LastError)
+}
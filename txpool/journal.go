@@ -0,0 +1,186 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+const (
+	journalOpAdd uint8 = iota
+	journalOpRemove
+)
+
+// journalEntry records a tx accepted by or removed from the pool.
+type journalEntry struct {
+	Op   uint8
+	Data []byte // rlp encoded tx to add, or hash of the tx to remove
+}
+
+// journal persists pooled txs in a file, to survive restarts.
+// Txs accepted and removed are appended to the file, which is compacted by rewriting with pooled txs.
+type journal struct {
+	path   string
+	lock   sync.Mutex
+	writer *os.File // nil until the first compaction, so that txs being loaded are not appended
+}
+
+func newJournal(path string) *journal {
+	return &journal{path: path}
+}
+
+// Load reads txs remaining in the journal, in the order of being added.
+// Entries partially written, e.g. on crash, at the tail of the file are ignored.
+// If the journal is corrupted, txs read before the corrupted entry are returned along with the error.
+func (j *journal) Load() (tx.Transactions, error) {
+	f, err := os.Open(j.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		stream  = rlp.NewStream(bufio.NewReader(f), 0)
+		txs     tx.Transactions
+		index   = make(map[thor.Bytes32]int)
+		loadErr error
+	)
+loop:
+	for {
+		var entry journalEntry
+		if err := stream.Decode(&entry); err != nil {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
+				loadErr = err
+			}
+			break
+		}
+		switch entry.Op {
+		case journalOpAdd:
+			var trx tx.Transaction
+			if err := rlp.DecodeBytes(entry.Data, &trx); err != nil {
+				loadErr = errors.Wrap(err, "decode tx")
+				break loop
+			}
+			if _, found := index[trx.Hash()]; !found {
+				index[trx.Hash()] = len(txs)
+				txs = append(txs, &trx)
+			}
+		case journalOpRemove:
+			if i, found := index[thor.BytesToBytes32(entry.Data)]; found {
+				// keep the slot to preserve order, and clean up below
+				txs[i] = nil
+				delete(index, thor.BytesToBytes32(entry.Data))
+			}
+		}
+	}
+
+	remaining := txs[:0]
+	for _, trx := range txs {
+		if trx != nil {
+			remaining = append(remaining, trx)
+		}
+	}
+	return remaining, loadErr
+}
+
+// Add appends the tx accepted by the pool.
+func (j *journal) Add(trx *tx.Transaction) error {
+	data, err := rlp.EncodeToBytes(trx)
+	if err != nil {
+		return err
+	}
+	return j.append(&journalEntry{journalOpAdd, data})
+}
+
+// Remove appends the removal of the tx of the given hash.
+func (j *journal) Remove(txHash thor.Bytes32) error {
+	return j.append(&journalEntry{journalOpRemove, txHash.Bytes()})
+}
+
+func (j *journal) append(entry *journalEntry) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+	return rlp.Encode(j.writer, entry)
+}
+
+// Compact rewrites the journal with the given txs, which are all txs in the pool.
+// It's atomic, the journal is replaced only when the new one is completely written.
+func (j *journal) Compact(txs tx.Transactions) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	tmpPath := j.path + ".new"
+	if err := writeJournal(tmpPath, txs); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if j.writer != nil {
+		j.writer.Close()
+		j.writer = nil
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+	writer, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	j.writer = writer
+	return nil
+}
+
+func writeJournal(path string, txs tx.Transactions) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, trx := range txs {
+		data, err := rlp.EncodeToBytes(trx)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if err := rlp.Encode(w, &journalEntry{journalOpAdd, data}); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Close closes the journal. Nothing will be appended after closed.
+func (j *journal) Close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	return err
+}
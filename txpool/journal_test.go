@@ -0,0 +1,168 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func txHashes(txs tx.Transactions) []thor.Bytes32 {
+	hashes := make([]thor.Bytes32, 0, len(txs))
+	for _, trx := range txs {
+		hashes = append(hashes, trx.Hash())
+	}
+	return hashes
+}
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "journal")
+
+	var txs tx.Transactions
+	for i := 0; i < 3; i++ {
+		txs = append(txs, newTx(0, nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[i]))
+	}
+
+	j := newJournal(path)
+	_, err = j.Load()
+	assert.True(t, os.IsNotExist(err))
+
+	// not appended before compacted
+	assert.Nil(t, j.Add(txs[0]))
+	assert.Nil(t, j.Compact(nil))
+	loaded, err := j.Load()
+	assert.Nil(t, err)
+	assert.Empty(t, loaded)
+
+	assert.Nil(t, j.Add(txs[0]))
+	assert.Nil(t, j.Add(txs[1]))
+	assert.Nil(t, j.Add(txs[2]))
+	assert.Nil(t, j.Add(txs[0]))
+	assert.Nil(t, j.Remove(txs[1].Hash()))
+	loaded, err = j.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, txHashes(tx.Transactions{txs[0], txs[2]}), txHashes(loaded))
+
+	assert.Nil(t, j.Compact(tx.Transactions{txs[2]}))
+	loaded, err = j.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, txHashes(tx.Transactions{txs[2]}), txHashes(loaded))
+	assert.Nil(t, j.Close())
+
+	// partially written entry at the tail
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0xf8, 0xff, 0x01})
+	f.Close()
+	loaded, err = j.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, txHashes(tx.Transactions{txs[2]}), txHashes(loaded))
+}
+
+func TestPoolJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	options := Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     time.Hour,
+		JournalFilePath: filepath.Join(dir, "txpool.journal"),
+	}
+
+	var (
+		acc       = genesis.DevAccounts()[0]
+		tx1       = newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)
+		tx2       = newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)
+		expiredTx = newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 0, nil, tx.Features(0), genesis.DevAccounts()[1])
+	)
+
+	pool := New(repo, state.NewStater(db), options)
+	assert.Nil(t, pool.Add(tx1))
+	assert.Nil(t, pool.Add(tx2))
+	assert.Nil(t, pool.Add(expiredTx))
+	assert.True(t, pool.Remove(tx2.Hash(), tx2.ID()))
+	pool.Close()
+
+	b1 := new(block.Builder).
+		ParentID(repo.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(repo.GenesisBlock().Header().StateRoot()).
+		Build()
+	repo.AddBlock(b1, nil)
+	repo.SetBestBlockID(b1.Header().ID())
+
+	// restarted
+	pool = New(repo, state.NewStater(db), options)
+	defer pool.Close()
+	assert.Equal(t, txHashes(tx.Transactions{tx1}), txHashes(pool.Dump()))
+}
+
+func TestPoolJournalWithRateLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	newOptions := func() Options {
+		policy, err := NewAdmissionPolicy(&AdmissionRules{RateLimit: &RateLimit{Txs: 1, Period: 3600}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Options{
+			Limit:           10,
+			LimitPerAccount: 2,
+			MaxLifetime:     time.Hour,
+			JournalFilePath: filepath.Join(dir, "txpool.journal"),
+			AdmissionPolicy: policy,
+		}
+	}
+
+	var (
+		acc = genesis.DevAccounts()[0]
+		tx1 = newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)
+		tx2 = newTx(repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc)
+	)
+
+	pool := New(repo, state.NewStater(db), newOptions())
+	assert.Nil(t, pool.Add(tx1))
+	assert.True(t, IsTxRejected(pool.Add(tx2)))
+	pool.Close()
+
+	// restarted, restored txs not counted against the rate limit
+	pool = New(repo, state.NewStater(db), newOptions())
+	defer pool.Close()
+	assert.Equal(t, txHashes(tx.Transactions{tx1}), txHashes(pool.Dump()))
+	assert.Nil(t, pool.Add(tx2))
+}
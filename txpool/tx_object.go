@@ -19,6 +19,9 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+// errKnownTx indicates the tx is already included in the chain.
+var errKnownTx = errors.New("known tx")
+
 type txObject struct {
 	*tx.Transaction
 	resolved *runtime.ResolvedTransaction
@@ -45,48 +48,73 @@ func (o *txObject) Origin() thor.Address {
 	return o.resolved.Origin
 }
 
+func (o *txObject) nonceKey() nonceKey {
+	return nonceKey{o.Origin(), o.Nonce(), o.ChainTag(), o.BlockRef(), o.Expiration()}
+}
+
+// IsCancellation returns whether the tx does nothing, which is used to cancel the pooled tx with the same nonce key.
+// That is, the tx has no clause, or only clauses sending nothing to the origin.
+func (o *txObject) IsCancellation() bool {
+	for _, c := range o.Clauses() {
+		if to := c.To(); to == nil || *to != o.Origin() || c.Value().Sign() != 0 || len(c.Data()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header) (bool, error) {
+	pending, err := o.checkExecutable(chain, state, headBlock)
+	if err != nil {
+		return false, err
+	}
+	return pending == "", nil
+}
+
+// checkExecutable returns the reason why the tx is pending, if it's not executable yet but may become executable later.
+// An error is returned if the tx will never be executable.
+func (o *txObject) checkExecutable(chain *chain.Chain, state *state.State, headBlock *block.Header) (pending string, err error) {
 	switch {
 	case o.Gas() > headBlock.GasLimit():
-		return false, errors.New("gas too large")
+		return "", errors.New("gas too large")
 	case o.IsExpired(headBlock.Number()):
-		return false, errors.New("expired")
+		return "", errors.New("expired")
 	case o.BlockRef().Number() > headBlock.Number()+uint32(3600*24/thor.BlockInterval):
-		return false, errors.New("block ref out of schedule")
+		return "", errors.New("block ref out of schedule")
 	}
 
 	if _, err := chain.GetTransactionMeta(o.ID()); err != nil {
 		if !chain.IsNotFound(err) {
-			return false, err
+			return "", err
 		}
 	} else {
-		return false, errors.New("known tx")
+		return "", errKnownTx
 	}
 
 	if dep := o.DependsOn(); dep != nil {
 		txMeta, err := chain.GetTransactionMeta(*dep)
 		if err != nil {
 			if chain.IsNotFound(err) {
-				return false, nil
+				return "dependency unsettled", nil
 			}
-			return false, err
+			return "", err
 		}
 		if txMeta.Reverted {
-			return false, errors.New("dep reverted")
+			return "", errors.New("dep reverted")
 		}
 	}
 
 	if o.BlockRef().Number() > headBlock.Number() {
-		return false, nil
+		return "future block ref", nil
 	}
 
 	checkpoint := state.NewCheckpoint()
 	defer state.RevertTo(checkpoint)
 
 	if _, _, _, _, err := o.resolved.BuyGas(state, headBlock.Timestamp()+thor.BlockInterval); err != nil {
-		return false, err
+		return "", err
 	}
-	return true, nil
+	return "", nil
 }
 
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
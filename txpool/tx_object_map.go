@@ -7,25 +7,43 @@ package txpool
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
+// percentage of gas price increase required to replace a pooled tx
+const replacePriceBump = 10
+
 // txObjectMap to maintain mapping of tx hash to tx object, and account quota.
 type txObjectMap struct {
-	lock      sync.RWMutex
-	mapByHash map[thor.Bytes32]*txObject
-	mapByID   map[thor.Bytes32]*txObject
-	quota     map[thor.Address]int
+	lock       sync.RWMutex
+	mapByHash  map[thor.Bytes32]*txObject
+	mapByID    map[thor.Bytes32]*txObject
+	mapByNonce map[nonceKey]*txObject
+	quota      map[thor.Address]int
+}
+
+// nonceKey identifies txs which replace each other.
+// Since nonces are arbitrary, the block ref and expiration are also required to be the same,
+// to not take an unrelated tx with the same nonce as a replacement.
+type nonceKey struct {
+	origin     thor.Address
+	nonce      uint64
+	chainTag   byte
+	blockRef   tx.BlockRef
+	expiration uint32
 }
 
 func newTxObjectMap() *txObjectMap {
 	return &txObjectMap{
-		mapByHash: make(map[thor.Bytes32]*txObject),
-		mapByID:   make(map[thor.Bytes32]*txObject),
-		quota:     make(map[thor.Address]int),
+		mapByHash:  make(map[thor.Bytes32]*txObject),
+		mapByID:    make(map[thor.Bytes32]*txObject),
+		mapByNonce: make(map[nonceKey]*txObject),
+		quota:      make(map[thor.Address]int),
 	}
 }
 
@@ -36,23 +54,52 @@ func (m *txObjectMap) ContainsHash(txHash thor.Bytes32) bool {
 	return found
 }
 
-func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
+// Add adds the tx object. If a tx with the same nonce key is in the map, the new one replaces it
+// when its overall gas price, priced by the given func, is high enough, and the replaced one is returned.
+func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int, overallGasPrice func(*txObject) *big.Int) (replaced *txObject, err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	hash := txObj.Hash()
-	if _, found := m.mapByHash[hash]; found {
-		return nil
+	if _, found := m.mapByHash[txObj.Hash()]; found {
+		return nil, nil
+	}
+
+	if existing, found := m.mapByNonce[txObj.nonceKey()]; found {
+		if !canReplace(overallGasPrice(existing), overallGasPrice(txObj)) {
+			return nil, fmt.Errorf("replacement tx underpriced, gas price bump %v%% required", replacePriceBump)
+		}
+		// the replacement takes over the quota of the replaced one
+		m.remove(existing)
+		m.add(txObj)
+		return existing, nil
 	}
 
 	if m.quota[txObj.Origin()] >= limitPerAccount {
-		return errors.New("account quota exceeded")
+		return nil, errors.New("account quota exceeded")
 	}
 
+	m.add(txObj)
+	return nil, nil
+}
+
+func (m *txObjectMap) add(txObj *txObject) {
 	m.quota[txObj.Origin()]++
-	m.mapByHash[hash] = txObj
+	m.mapByHash[txObj.Hash()] = txObj
 	m.mapByID[txObj.ID()] = txObj
-	return nil
+	m.mapByNonce[txObj.nonceKey()] = txObj
+}
+
+func (m *txObjectMap) remove(txObj *txObject) {
+	if m.quota[txObj.Origin()] > 1 {
+		m.quota[txObj.Origin()]--
+	} else {
+		delete(m.quota, txObj.Origin())
+	}
+	delete(m.mapByHash, txObj.Hash())
+	delete(m.mapByID, txObj.ID())
+	if m.mapByNonce[txObj.nonceKey()] == txObj {
+		delete(m.mapByNonce, txObj.nonceKey())
+	}
 }
 
 func (m *txObjectMap) GetByID(id thor.Bytes32) *txObject {
@@ -66,13 +113,7 @@ func (m *txObjectMap) RemoveByHash(txHash thor.Bytes32) bool {
 	defer m.lock.Unlock()
 
 	if txObj, ok := m.mapByHash[txHash]; ok {
-		if m.quota[txObj.Origin()] > 1 {
-			m.quota[txObj.Origin()]--
-		} else {
-			delete(m.quota, txObj.Origin())
-		}
-		delete(m.mapByHash, txHash)
-		delete(m.mapByID, txObj.ID())
+		m.remove(txObj)
 		return true
 	}
 	return false
@@ -100,19 +141,24 @@ func (m *txObjectMap) ToTxs() tx.Transactions {
 	return txs
 }
 
-func (m *txObjectMap) Fill(txObjs []*txObject) {
+// Fill adds txs without account limit check, and returns the ones actually added.
+func (m *txObjectMap) Fill(txObjs []*txObject) (added []*txObject) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	for _, txObj := range txObjs {
 		if _, found := m.mapByHash[txObj.Hash()]; found {
 			continue
 		}
+		// keep the pooled one, if any, with the same nonce key
+		if _, found := m.mapByNonce[txObj.nonceKey()]; found {
+			continue
+		}
 		// skip account limit check
 
-		m.quota[txObj.Origin()]++
-		m.mapByHash[txObj.Hash()] = txObj
-		m.mapByID[txObj.ID()] = txObj
+		m.add(txObj)
+		added = append(added, txObj)
 	}
+	return
 }
 
 func (m *txObjectMap) Len() int {
@@ -121,3 +167,10 @@ func (m *txObjectMap) Len() int {
 
 	return len(m.mapByHash)
 }
+
+// canReplace returns whether the new overall gas price is high enough to replace the existing one.
+func canReplace(existingPrice, newPrice *big.Int) bool {
+	x := new(big.Int).Mul(newPrice, big.NewInt(100))
+	y := new(big.Int).Mul(existingPrice, big.NewInt(100+replacePriceBump))
+	return x.Cmp(y) >= 0
+}
@@ -7,14 +7,20 @@ package txpool
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/muxdb"
+	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
+func overallGasPrice(txObj *txObject) *big.Int {
+	return txObj.OverallGasPrice(thor.InitialBaseGasPrice, &big.Int{})
+}
+
 func TestTxObjMap(t *testing.T) {
 	db := muxdb.NewMem()
 	repo := newChainRepo(db)
@@ -30,14 +36,18 @@ func TestTxObjMap(t *testing.T) {
 	m := newTxObjectMap()
 	assert.Zero(t, m.Len())
 
-	assert.Nil(t, m.Add(txObj1, 1))
-	assert.Nil(t, m.Add(txObj1, 1), "should no error if exists")
+	_, err := m.Add(txObj1, 1, overallGasPrice)
+	assert.Nil(t, err)
+	_, err = m.Add(txObj1, 1, overallGasPrice)
+	assert.Nil(t, err, "should no error if exists")
 	assert.Equal(t, 1, m.Len())
 
-	assert.Equal(t, errors.New("account quota exceeded"), m.Add(txObj2, 1))
+	_, err = m.Add(txObj2, 1, overallGasPrice)
+	assert.Equal(t, errors.New("account quota exceeded"), err)
 	assert.Equal(t, 1, m.Len())
 
-	assert.Nil(t, m.Add(txObj3, 1))
+	_, err = m.Add(txObj3, 1, overallGasPrice)
+	assert.Nil(t, err)
 	assert.Equal(t, 2, m.Len())
 
 	assert.True(t, m.ContainsHash(tx1.Hash()))
@@ -52,3 +62,90 @@ func TestTxObjMap(t *testing.T) {
 	assert.Equal(t, tx.Transactions{tx3}, m.ToTxs())
 
 }
+
+func TestTxObjMapReplace(t *testing.T) {
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	acc := genesis.DevAccounts()[0]
+
+	newTxObj := func(gasPriceCoef uint8, clauses ...*tx.Clause) *txObject {
+		builder := new(tx.Builder).ChainTag(repo.ChainTag())
+		for _, c := range clauses {
+			builder.Clause(c)
+		}
+		trx := builder.Expiration(100).Nonce(1).GasPriceCoef(gasPriceCoef).Gas(21000).Build()
+		txObj, _ := resolveTx(signTx(trx, acc))
+		return txObj
+	}
+
+	to := thor.BytesToAddress([]byte("to"))
+	txObj1 := newTxObj(0, tx.NewClause(&to).WithValue(big.NewInt(1)))
+	txObj2 := newTxObj(10, tx.NewClause(&to).WithValue(big.NewInt(1)))
+	txObj3 := newTxObj(30)
+
+	m := newTxObjectMap()
+	replaced, err := m.Add(txObj1, 1, overallGasPrice)
+	assert.Nil(t, err)
+	assert.Nil(t, replaced)
+
+	_, err = m.Add(txObj2, 1, overallGasPrice)
+	assert.EqualError(t, err, "replacement tx underpriced, gas price bump 10% required")
+	assert.True(t, m.ContainsHash(txObj1.Hash()))
+
+	replaced, err = m.Add(txObj3, 1, overallGasPrice)
+	assert.Nil(t, err)
+	assert.Equal(t, txObj1, replaced)
+	assert.Equal(t, []*txObject{txObj3}, m.ToTxObjects())
+	assert.Nil(t, m.GetByID(txObj1.ID()))
+	assert.True(t, txObj3.IsCancellation())
+	assert.False(t, txObj1.IsCancellation())
+
+	assert.True(t, m.RemoveByHash(txObj3.Hash()))
+	assert.Zero(t, m.Len())
+	_, err = m.Add(txObj2, 1, overallGasPrice)
+	assert.Nil(t, err, "nonce released after removal")
+}
+
+func TestTxObjMapReplaceByOverallGasPrice(t *testing.T) {
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	acc := genesis.DevAccounts()[0]
+
+	newTxObj := func(expiration uint32, gasPriceCoef uint8) *txObject {
+		trx := new(tx.Builder).
+			ChainTag(repo.ChainTag()).
+			Expiration(expiration).
+			Nonce(1).
+			GasPriceCoef(gasPriceCoef).
+			Gas(21000).
+			Build()
+		txObj, _ := resolveTx(signTx(trx, acc))
+		return txObj
+	}
+	txObj1 := newTxObj(100, 0)
+	txObj2 := newTxObj(200, 0)
+
+	m := newTxObjectMap()
+	_, err := m.Add(txObj1, 2, overallGasPrice)
+	assert.Nil(t, err)
+
+	// not a replacement with different expiration
+	replaced, err := m.Add(txObj2, 2, overallGasPrice)
+	assert.Nil(t, err)
+	assert.Nil(t, replaced)
+	assert.Equal(t, 2, m.Len())
+
+	// priced by proved work
+	txObj3 := newTxObj(100, 1)
+	withWork := func(txObj *txObject) *big.Int {
+		if txObj == txObj3 {
+			return txObj.OverallGasPrice(thor.InitialBaseGasPrice, big.NewInt(1e7))
+		}
+		return overallGasPrice(txObj)
+	}
+	_, err = m.Add(txObj3, 2, overallGasPrice)
+	assert.NotNil(t, err)
+	replaced, err = m.Add(txObj3, 2, withWork)
+	assert.Nil(t, err)
+	assert.Equal(t, txObj1, replaced)
+}
@@ -7,6 +7,8 @@ package txpool
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"math/big"
 	"math/rand"
 	"os"
 	"sync/atomic"
@@ -15,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/event"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/inconshreveable/log15"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
@@ -28,6 +31,10 @@ import (
 const (
 	// max size of tx allowed
 	maxTxSize = 64 * 1024
+	// max count of evicted txs to remember
+	maxEvictedTxs = 4096
+	// interval to compact the journal
+	journalCompactInterval = time.Minute * 10
 )
 
 var (
@@ -40,13 +47,47 @@ type Options struct {
 	LimitPerAccount        int
 	MaxLifetime            time.Duration
 	BlocklistCacheFilePath string
-	BlocklistFetchURL      string
+	BlocklistFetchURLs     []string           // sources of blocklist, which are merged
+	BlocklistAllowlist     []thor.Address     // addresses never blocked by fetched blocklists
+	BlocklistPublicKeys    []*ecdsa.PublicKey // to verify signatures of fetched blocklists, not verified if empty
+	JournalFilePath        string             // to persist pooled txs across restarts, disabled if empty
+	AdmissionPolicy        AdmissionPolicy    // to reject txs by rules, all txs admitted if nil
 }
 
+// TxStatus is the lifecycle status of a tx in the pool.
+type TxStatus string
+
+// lifecycle status of txs in the pool.
+const (
+	TxStatusAdded      TxStatus = "added"      // added into the pool
+	TxStatusExecutable TxStatus = "executable" // became executable
+	TxStatusEvicted    TxStatus = "evicted"    // removed from the pool without being included
+	TxStatusIncluded   TxStatus = "included"   // removed from the pool for being included in the chain
+)
+
 // TxEvent will be posted when tx is added or status changed.
 type TxEvent struct {
 	Tx         *tx.Transaction
-	Executable *bool
+	Status     TxStatus
+	Executable *bool        // nil if unknown, or the tx is removed
+	Reason     string       // reason of eviction
+	Replaced   *Replacement // set if Tx replaced a pooled tx
+}
+
+// Eviction describes why a tx is evicted from the pool.
+type Eviction struct {
+	TxID       thor.Bytes32
+	Reason     string
+	ReplacedBy *thor.Bytes32 // ID of the replacing tx, if evicted by replacement
+	Time       int64         // unix timestamp when evicted
+}
+
+// Replacement describes a pooled tx replaced by another one with the same nonce key, i.e. the same origin, nonce,
+// chain tag, block ref and expiration.
+type Replacement struct {
+	TxID       thor.Bytes32 // ID of the replaced tx
+	ReplacedBy thor.Bytes32 // ID of the replacing tx
+	Cancelled  bool         // whether the replacing tx does nothing, which cancels the replaced one in this pool only
 }
 
 // TxPool maintains unprocessed transactions.
@@ -54,10 +95,12 @@ type TxPool struct {
 	options   Options
 	repo      *chain.Repository
 	stater    *state.Stater
-	blocklist blocklist
+	blocklist *blocklist
+	journal   *journal
 
 	executables    atomic.Value
 	all            *txObjectMap
+	evicted        *lru.Cache
 	addedAfterWash uint32
 
 	ctx    context.Context
@@ -71,13 +114,22 @@ type TxPool struct {
 // Shutdown is required to be called at end.
 func New(repo *chain.Repository, stater *state.Stater, options Options) *TxPool {
 	ctx, cancel := context.WithCancel(context.Background())
+	evicted, _ := lru.New(maxEvictedTxs)
 	pool := &TxPool{
-		options: options,
-		repo:    repo,
-		stater:  stater,
-		all:     newTxObjectMap(),
-		ctx:     ctx,
-		cancel:  cancel,
+		options:   options,
+		repo:      repo,
+		stater:    stater,
+		blocklist: newBlocklist(options.BlocklistFetchURLs, options.BlocklistAllowlist, options.BlocklistPublicKeys),
+		all:       newTxObjectMap(),
+		evicted:   evicted,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	if options.JournalFilePath != "" {
+		pool.journal = newJournal(options.JournalFilePath)
+		pool.loadJournal()
+		pool.goes.Go(pool.journalLoop)
 	}
 
 	pool.goes.Go(pool.housekeeping)
@@ -85,6 +137,76 @@ func New(repo *chain.Repository, stater *state.Stater, options Options) *TxPool
 	return pool
 }
 
+// loadJournal replays txs in the journal, except expired or blocked ones, and then starts journaling.
+// The admission policy is skipped, since the txs were admitted before.
+func (p *TxPool) loadJournal() {
+	txs, err := p.journal.Load()
+	if err != nil && !os.IsNotExist(err) {
+		log.Warn("journal load failed", "error", err, "path", p.options.JournalFilePath)
+	}
+
+	var (
+		bestNum = p.repo.BestBlock().Header().Number()
+		added   int
+		dropped int
+	)
+	for _, trx := range txs {
+		if trx.IsExpired(bestNum) {
+			dropped++
+			continue
+		}
+		if err := p.add(trx, false, false); err != nil {
+			dropped++
+			continue
+		}
+		added++
+	}
+	if len(txs) > 0 {
+		log.Info("journal loaded", "added", added, "dropped", dropped)
+	}
+	p.compactJournal()
+}
+
+func (p *TxPool) journalLoop() {
+	ticker := time.NewTicker(journalCompactInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.compactJournal()
+		}
+	}
+}
+
+func (p *TxPool) compactJournal() {
+	if err := p.journal.Compact(p.all.ToTxs()); err != nil {
+		log.Warn("journal compact failed", "error", err, "path", p.options.JournalFilePath)
+	} else {
+		log.Debug("journal compacted")
+	}
+}
+
+// journalAdd records the tx accepted, if journal enabled.
+func (p *TxPool) journalAdd(trx *tx.Transaction) {
+	if p.journal != nil {
+		if err := p.journal.Add(trx); err != nil {
+			log.Warn("journal tx failed", "id", trx.ID(), "error", err)
+		}
+	}
+}
+
+// journalRemove records the tx removed, if journal enabled.
+func (p *TxPool) journalRemove(txHash, txID thor.Bytes32) {
+	if p.journal != nil {
+		if err := p.journal.Remove(txHash); err != nil {
+			log.Warn("journal tx removal failed", "id", txID, "error", err)
+		}
+	}
+}
+
 func (p *TxPool) housekeeping() {
 	log.Debug("enter housekeeping")
 	defer log.Debug("leave housekeeping")
@@ -143,7 +265,7 @@ func (p *TxPool) housekeeping() {
 func (p *TxPool) fetchBlocklistLoop() {
 	var (
 		path = p.options.BlocklistCacheFilePath
-		url  = p.options.BlocklistFetchURL
+		urls = p.blocklist.URLs()
 	)
 
 	if path != "" {
@@ -155,25 +277,32 @@ func (p *TxPool) fetchBlocklistLoop() {
 			log.Debug("blocklist loaded", "len", p.blocklist.Len())
 		}
 	}
-	if url == "" {
+	if len(urls) == 0 {
 		return
 	}
 
-	var eTag string
 	fetch := func() {
-		if err := p.blocklist.Fetch(p.ctx, url, &eTag); err != nil {
-			if err == context.Canceled {
-				return
-			}
-			log.Warn("blocklist fetch failed", "error", err, "url", url)
-		} else {
-			log.Debug("blocklist fetched", "len", p.blocklist.Len())
-			if path != "" {
-				if err := p.blocklist.Save(path); err != nil {
-					log.Warn("blocklist save failed", "error", err, "path", path)
-				} else {
-					log.Debug("blocklist saved")
+		var updated bool
+		for _, url := range urls {
+			ok, err := p.blocklist.Fetch(p.ctx, url)
+			if err != nil {
+				if p.ctx.Err() != nil {
+					return
 				}
+				log.Warn("blocklist fetch failed", "error", err, "url", redactURL(url))
+				continue
+			}
+			updated = updated || ok
+		}
+		if !updated {
+			return
+		}
+		log.Debug("blocklist fetched", "len", p.blocklist.Len())
+		if path != "" {
+			if err := p.blocklist.Save(path); err != nil {
+				log.Warn("blocklist save failed", "error", err, "path", path)
+			} else {
+				log.Debug("blocklist saved")
 			}
 		}
 	}
@@ -197,6 +326,12 @@ func (p *TxPool) Close() {
 	p.cancel()
 	p.scope.Close()
 	p.goes.Wait()
+	if p.journal != nil {
+		p.compactJournal()
+		if err := p.journal.Close(); err != nil {
+			log.Warn("journal close failed", "error", err)
+		}
+	}
 	log.Debug("closed")
 }
 
@@ -205,7 +340,7 @@ func (p *TxPool) SubscribeTxEvent(ch chan *TxEvent) event.Subscription {
 	return p.scope.Track(p.txFeed.Subscribe(ch))
 }
 
-func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
+func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, admit bool) error {
 	if p.all.ContainsHash(newTx.Hash()) {
 		// tx already in the pool
 		return nil
@@ -236,6 +371,23 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 		return badTxError{err.Error()}
 	}
 
+	added := false
+	if policy := p.options.AdmissionPolicy; policy != nil && admit {
+		if err := policy.Admit(newTx, txObj.Origin()); err != nil {
+			return txRejectedError{"not admitted: " + err.Error()}
+		}
+		defer func() {
+			if !added {
+				policy.Rejected(newTx, txObj.Origin())
+			}
+		}()
+	}
+
+	overallGasPrice, err := p.overallGasPricer(headBlock)
+	if err != nil {
+		return err
+	}
+
 	if isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp()) {
 		state := p.stater.NewState(headBlock.StateRoot())
 		executable, err := txObj.Executable(p.repo.NewChain(headBlock.ID()), state, headBlock)
@@ -247,13 +399,20 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 			return txRejectedError{"tx is not executable"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, overallGasPrice)
+		if err != nil {
 			return txRejectedError{err.Error()}
 		}
+		added = true
+		replacement, replacedEv := p.onReplaced(replaced, txObj)
+		p.journalAdd(newTx)
 
 		txObj.executable = executable
 		p.goes.Go(func() {
-			p.txFeed.Send(&TxEvent{newTx, &executable})
+			if replacedEv != nil {
+				p.txFeed.Send(replacedEv)
+			}
+			p.txFeed.Send(&TxEvent{Tx: newTx, Status: TxStatusAdded, Executable: &executable, Replaced: replacement})
 		})
 		log.Debug("tx added", "id", newTx.ID(), "executable", executable)
 	} else {
@@ -263,20 +422,85 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 			return txRejectedError{"pool is full"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, overallGasPrice)
+		if err != nil {
 			return txRejectedError{err.Error()}
 		}
+		added = true
+		replacement, replacedEv := p.onReplaced(replaced, txObj)
+		p.journalAdd(newTx)
 		log.Debug("tx added", "id", newTx.ID())
-		p.txFeed.Send(&TxEvent{newTx, nil})
+		if replacedEv != nil {
+			p.txFeed.Send(replacedEv)
+		}
+		p.txFeed.Send(&TxEvent{Tx: newTx, Status: TxStatusAdded, Replaced: replacement})
 	}
 	atomic.AddUint32(&p.addedAfterWash, 1)
 	return nil
 }
 
+// overallGasPricer returns the func to price txs by overall gas price, against the head block.
+func (p *TxPool) overallGasPricer(headBlock *block.Header) (func(*txObject) *big.Int, error) {
+	state := p.stater.NewState(headBlock.StateRoot())
+	baseGasPrice, err := builtin.Params.Native(state).Get(thor.KeyBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	chain := p.repo.NewChain(headBlock.ID())
+	return func(txObj *txObject) *big.Int {
+		provedWork, err := txObj.ProvedWork(headBlock.Number(), chain.GetBlockID)
+		if err != nil {
+			// priced without work if the work can't be proved
+			provedWork = &big.Int{}
+		}
+		return txObj.OverallGasPrice(baseGasPrice, provedWork)
+	}, nil
+}
+
+// onReplaced records the eviction of the replaced tx, if any, which is already removed from the tx object map.
+func (p *TxPool) onReplaced(replaced, by *txObject) (*Replacement, *TxEvent) {
+	if replaced == nil {
+		return nil, nil
+	}
+	r := &Replacement{
+		TxID:       replaced.ID(),
+		ReplacedBy: by.ID(),
+		Cancelled:  by.IsCancellation(),
+	}
+	reason := "replaced"
+	if r.Cancelled {
+		reason = "cancelled"
+	}
+	log.Debug("tx replaced", "id", r.TxID, "by", r.ReplacedBy, "cancelled", r.Cancelled)
+	return r, p.onRemoved(replaced, TxStatusEvicted, reason, &r.ReplacedBy)
+}
+
+// removeTx removes the tx from the pool, for being evicted with the reason or included.
+// The event to be posted is returned, or nil if the tx is not in the pool.
+func (p *TxPool) removeTx(txObj *txObject, status TxStatus, reason string) *TxEvent {
+	if !p.all.RemoveByHash(txObj.Hash()) {
+		return nil
+	}
+	return p.onRemoved(txObj, status, reason, nil)
+}
+
+func (p *TxPool) onRemoved(txObj *txObject, status TxStatus, reason string, replacedBy *thor.Bytes32) *TxEvent {
+	p.journalRemove(txObj.Hash(), txObj.ID())
+	if status == TxStatusEvicted {
+		p.evicted.Add(txObj.ID(), &Eviction{
+			TxID:       txObj.ID(),
+			Reason:     reason,
+			ReplacedBy: replacedBy,
+			Time:       time.Now().Unix(),
+		})
+	}
+	return &TxEvent{Tx: txObj.Transaction, Status: status, Reason: reason}
+}
+
 // Add add new tx into pool.
 // It's not assumed as an error if the tx to be added is already in the pool,
 func (p *TxPool) Add(newTx *tx.Transaction) error {
-	return p.add(newTx, false)
+	return p.add(newTx, false, true)
 }
 
 // Get get pooled tx by id.
@@ -287,14 +511,59 @@ func (p *TxPool) Get(id thor.Bytes32) *tx.Transaction {
 	return nil
 }
 
+// Why explains why the pooled tx of the given id is not executable on the best block.
+// An empty reason is returned if the tx is executable, and false if the tx is not in the pool.
+func (p *TxPool) Why(id thor.Bytes32) (string, bool) {
+	txObj := p.all.GetByID(id)
+	if txObj == nil {
+		return "", false
+	}
+
+	if thor.IsOriginBlocked(txObj.Origin()) || p.blocklist.Contains(txObj.Origin()) {
+		return "origin blocked", true
+	}
+
+	headBlock := p.repo.BestBlock().Header()
+	state := p.stater.NewState(headBlock.StateRoot())
+	pending, err := txObj.checkExecutable(p.repo.NewChain(headBlock.ID()), state, headBlock)
+	if err != nil {
+		return err.Error(), true
+	}
+	return pending, true
+}
+
+// Evicted returns the eviction of the tx of the given id, if it was evicted recently.
+func (p *TxPool) Evicted(id thor.Bytes32) *Eviction {
+	if e, ok := p.evicted.Get(id); ok {
+		return e.(*Eviction)
+	}
+	return nil
+}
+
+// BlocklistStatus returns the status of the blocklist.
+func (p *TxPool) BlocklistStatus() *BlocklistStatus {
+	return p.blocklist.Status()
+}
+
+// Options returns options of the pool.
+func (p *TxPool) Options() Options {
+	return p.options
+}
+
 // StrictlyAdd add new tx into pool. A rejection error will be returned, if tx is not executable at this time.
 func (p *TxPool) StrictlyAdd(newTx *tx.Transaction) error {
-	return p.add(newTx, true)
+	return p.add(newTx, true, true)
 }
 
 // Remove removes tx from pool by its Hash.
+// It's called by packers to drop txs not adoptable, so the tx is recorded as evicted.
 func (p *TxPool) Remove(txHash thor.Bytes32, txID thor.Bytes32) bool {
-	if p.all.RemoveByHash(txHash) {
+	txObj := p.all.GetByID(txID)
+	if txObj == nil || txObj.Hash() != txHash {
+		return false
+	}
+	if ev := p.removeTx(txObj, TxStatusEvicted, "not adoptable"); ev != nil {
+		p.goes.Go(func() { p.txFeed.Send(ev) })
 		log.Debug("tx removed", "id", txID)
 		return true
 	}
@@ -302,27 +571,62 @@ func (p *TxPool) Remove(txHash thor.Bytes32, txID thor.Bytes32) bool {
 }
 
 // Executables returns executable txs.
+// Txs removed from the pool since the last washing, e.g. replaced ones, are excluded.
 func (p *TxPool) Executables() tx.Transactions {
-	if sorted := p.executables.Load(); sorted != nil {
-		return sorted.(tx.Transactions)
+	sorted, _ := p.executables.Load().(tx.Transactions)
+	for i, trx := range sorted {
+		if !p.all.ContainsHash(trx.Hash()) {
+			// copy on removal, the washed ones are shared
+			executables := append(make(tx.Transactions, 0, len(sorted)-1), sorted[:i]...)
+			for _, trx := range sorted[i+1:] {
+				if p.all.ContainsHash(trx.Hash()) {
+					executables = append(executables, trx)
+				}
+			}
+			return executables
+		}
 	}
-	return nil
+	return sorted
 }
 
 // Fill fills txs into pool.
+// Txs are subject to the admission policy, as they may be stashed under different rules.
 func (p *TxPool) Fill(txs tx.Transactions) {
+	policy := p.options.AdmissionPolicy
 	txObjs := make([]*txObject, 0, len(txs))
 	for _, tx := range txs {
 		origin, _ := tx.Origin()
 		if thor.IsOriginBlocked(origin) || p.blocklist.Contains(origin) {
 			continue
 		}
+		if p.all.ContainsHash(tx.Hash()) {
+			continue
+		}
 		// here we ignore errors
-		if txObj, err := resolveTx(tx); err == nil {
-			txObjs = append(txObjs, txObj)
+		txObj, err := resolveTx(tx)
+		if err != nil {
+			continue
+		}
+		if policy != nil {
+			if err := policy.Admit(tx, origin); err != nil {
+				continue
+			}
+		}
+		txObjs = append(txObjs, txObj)
+	}
+	added := p.all.Fill(txObjs)
+	if policy != nil && len(added) < len(txObjs) {
+		// give back for admitted txs not filled, e.g. nonce already pooled
+		filled := make(map[thor.Bytes32]bool, len(added))
+		for _, txObj := range added {
+			filled[txObj.Hash()] = true
+		}
+		for _, txObj := range txObjs {
+			if !filled[txObj.Hash()] {
+				policy.Rejected(txObj.Transaction, txObj.Origin())
+			}
 		}
 	}
-	p.all.Fill(txObjs)
 }
 
 // Dump dumps all txs in the pool.
@@ -330,12 +634,23 @@ func (p *TxPool) Dump() tx.Transactions {
 	return p.all.ToTxs()
 }
 
+// txRemoval is a tx to be removed by washing.
+type txRemoval struct {
+	*txObject
+	status TxStatus
+	reason string
+}
+
 // wash to evict txs that are over limit, out of lifetime, out of energy, settled, expired or dep broken.
 // this method should only be called in housekeeping go routine
 func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, removed int, err error) {
 	all := p.all.ToTxObjects()
-	var toRemove []*txObject
+	var toRemove []txRemoval
+	evict := func(txObj *txObject, reason string) {
+		toRemove = append(toRemove, txRemoval{txObj, TxStatusEvicted, reason})
+	}
 	defer func() {
+		var events []*TxEvent
 		if err != nil {
 			// in case of error, simply cut pool size to limit
 			for i, txObj := range all {
@@ -343,14 +658,25 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 					break
 				}
 				removed++
-				p.all.RemoveByHash(txObj.Hash())
+				if ev := p.removeTx(txObj, TxStatusEvicted, "pool limit"); ev != nil {
+					events = append(events, ev)
+				}
 			}
 		} else {
-			for _, txObj := range toRemove {
-				p.all.RemoveByHash(txObj.Hash())
+			for _, r := range toRemove {
+				if ev := p.removeTx(r.txObject, r.status, r.reason); ev != nil {
+					events = append(events, ev)
+				}
 			}
 			removed = len(toRemove)
 		}
+		if len(events) > 0 {
+			p.goes.Go(func() {
+				for _, ev := range events {
+					p.txFeed.Send(ev)
+				}
+			})
+		}
 	}()
 
 	state := p.stater.NewState(headBlock.StateRoot())
@@ -367,21 +693,25 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	)
 	for _, txObj := range all {
 		if thor.IsOriginBlocked(txObj.Origin()) || p.blocklist.Contains(txObj.Origin()) {
-			toRemove = append(toRemove, txObj)
+			evict(txObj, "blocked")
 			log.Debug("tx washed out", "id", txObj.ID(), "err", "blocked")
 			continue
 		}
 
 		// out of lifetime
 		if now > txObj.timeAdded+int64(p.options.MaxLifetime) {
-			toRemove = append(toRemove, txObj)
+			evict(txObj, "out of lifetime")
 			log.Debug("tx washed out", "id", txObj.ID(), "err", "out of lifetime")
 			continue
 		}
 		// settled, out of energy or dep broken
 		executable, err := txObj.Executable(chain, state, headBlock)
 		if err != nil {
-			toRemove = append(toRemove, txObj)
+			if err == errKnownTx {
+				toRemove = append(toRemove, txRemoval{txObj, TxStatusIncluded, ""})
+			} else {
+				evict(txObj, err.Error())
+			}
 			log.Debug("tx washed out", "id", txObj.ID(), "err", err)
 			continue
 		}
@@ -389,7 +719,7 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 		if executable {
 			provedWork, err := txObj.ProvedWork(headBlock.Number(), chain.GetBlockID)
 			if err != nil {
-				toRemove = append(toRemove, txObj)
+				evict(txObj, err.Error())
 				log.Debug("tx washed out", "id", txObj.ID(), "err", err)
 				continue
 			}
@@ -408,18 +738,18 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	// remove over limit txs, from non-executables to low priced
 	if len(executableObjs) > limit {
 		for _, txObj := range nonExecutableObjs {
-			toRemove = append(toRemove, txObj)
+			evict(txObj, "pool limit")
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 		for _, txObj := range executableObjs[limit:] {
-			toRemove = append(toRemove, txObj)
+			evict(txObj, "pool limit")
 			log.Debug("executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 		executableObjs = executableObjs[:limit]
 	} else if len(executableObjs)+len(nonExecutableObjs) > limit {
 		// executableObjs + nonExecutableObjs over pool limit
 		for _, txObj := range nonExecutableObjs[limit-len(executableObjs):] {
-			toRemove = append(toRemove, txObj)
+			evict(txObj, "pool limit")
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 	}
@@ -438,7 +768,7 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	p.goes.Go(func() {
 		for _, tx := range toBroadcast {
 			executable := true
-			p.txFeed.Send(&TxEvent{tx, &executable})
+			p.txFeed.Send(&TxEvent{Tx: tx, Status: TxStatusExecutable, Executable: &executable})
 		}
 	})
 	return executables, 0, nil
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/inconshreveable/log15"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +63,102 @@ func TestSubscribeNewTx(t *testing.T) {
 	assert.Nil(t, pool.Add(tx))
 
 	v := true
-	assert.Equal(t, &TxEvent{tx, &v}, <-txCh)
+	assert.Equal(t, &TxEvent{Tx: tx, Status: TxStatusAdded, Executable: &v}, <-txCh)
+}
+
+func TestReplaceTx(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	newTx := func(gasPriceCoef uint8) *Tx.Transaction {
+		trx := new(Tx.Builder).
+			ChainTag(pool.repo.ChainTag()).
+			Expiration(100).
+			Nonce(1).
+			GasPriceCoef(gasPriceCoef).
+			Gas(21000).
+			Build()
+		return signTx(trx, acc)
+	}
+	tx1, tx2 := newTx(0), newTx(100)
+
+	txCh := make(chan *TxEvent, 2)
+	pool.SubscribeTxEvent(txCh)
+
+	assert.Nil(t, pool.Add(tx1))
+	<-txCh
+	executables, _, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	pool.executables.Store(executables)
+	assert.Equal(t, Tx.Transactions{tx1}, pool.Executables())
+
+	assert.True(t, IsTxRejected(pool.Add(newTx(1))))
+	assert.Nil(t, pool.Add(tx2))
+	// the replaced one not packed before next washing
+	assert.Zero(t, len(pool.Executables()))
+
+	assert.Equal(t, &TxEvent{Tx: tx1, Status: TxStatusEvicted, Reason: "cancelled"}, <-txCh)
+	replacement := &Replacement{TxID: tx1.ID(), ReplacedBy: tx2.ID(), Cancelled: true}
+	assert.Equal(t, replacement, (<-txCh).Replaced)
+
+	eviction := pool.Evicted(tx1.ID())
+	assert.Equal(t, "cancelled", eviction.Reason)
+	assert.Equal(t, tx2.ID(), *eviction.ReplacedBy)
+	assert.Nil(t, pool.Evicted(tx2.ID()))
+	assert.Nil(t, pool.Get(tx1.ID()))
+	assert.Equal(t, tx2, pool.Get(tx2.ID()))
+}
+
+func TestAddSameNonce(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	newTx := func(expiration uint32) *Tx.Transaction {
+		trx := new(Tx.Builder).
+			ChainTag(pool.repo.ChainTag()).
+			Expiration(expiration).
+			Nonce(1).
+			Gas(21000).
+			Build()
+		return signTx(trx, acc)
+	}
+	tx1, tx2 := newTx(100), newTx(200)
+
+	// unrelated txs may have the same nonce
+	assert.Nil(t, pool.Add(tx1))
+	assert.Nil(t, pool.Add(tx2))
+	assert.Equal(t, tx1, pool.Get(tx1.ID()))
+	assert.Equal(t, tx2, pool.Get(tx2.ID()))
+}
+
+func TestWashEvicted(t *testing.T) {
+	db := muxdb.NewMem()
+	repo := newChainRepo(db)
+	pool := New(repo, state.NewStater(db), Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     0,
+	})
+	defer pool.Close()
+
+	txCh := make(chan *TxEvent, 2)
+	pool.SubscribeTxEvent(txCh)
+
+	trx := newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), genesis.DevAccounts()[0])
+	assert.Nil(t, pool.Add(trx))
+	assert.Equal(t, TxStatusAdded, (<-txCh).Status)
+
+	_, removed, err := pool.wash(pool.repo.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, &TxEvent{Tx: trx, Status: TxStatusEvicted, Reason: "out of lifetime"}, <-txCh)
+
+	eviction := pool.Evicted(trx.ID())
+	assert.Equal(t, trx.ID(), eviction.TxID)
+	assert.Equal(t, "out of lifetime", eviction.Reason)
+	assert.Nil(t, eviction.ReplacedBy)
 }
 
 func TestWashTxs(t *testing.T) {
@@ -173,3 +269,32 @@ func TestBeforeVIP191Add(t *testing.T) {
 
 	assert.Equal(t, "tx rejected: unsupported features", err.Error())
 }
+
+func TestWhy(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	key, _ := crypto.GenerateKey()
+	poor := genesis.DevAccount{Address: thor.Address(crypto.PubkeyToAddress(key.PublicKey)), PrivateKey: key}
+
+	tests := []struct {
+		tx     *tx.Transaction
+		reason string
+	}{
+		{newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), acc), ""},
+		{newTx(pool.repo.ChainTag(), nil, 21000, tx.NewBlockRef(200), 100, nil, tx.Features(0), acc), "future block ref"},
+		{newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, &thor.Bytes32{1}, tx.Features(0), poor), "dependency unsettled"},
+		{newTx(pool.repo.ChainTag(), nil, 21000, tx.BlockRef{}, 100, nil, tx.Features(0), poor), "insufficient energy"},
+	}
+
+	for _, tt := range tests {
+		assert.Nil(t, pool.Add(tt.tx))
+		reason, ok := pool.Why(tt.tx.ID())
+		assert.True(t, ok)
+		assert.Equal(t, tt.reason, reason)
+	}
+
+	_, ok := pool.Why(thor.Bytes32{})
+	assert.False(t, ok)
+}